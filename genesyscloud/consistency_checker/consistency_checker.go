@@ -32,6 +32,7 @@ type consistencyCheck struct {
 	originalState map[string]interface{}
 	meta          interface{}
 	isEmptyState  *bool
+	ignoredAttrs  []string
 }
 
 type consistencyError struct {
@@ -46,7 +47,10 @@ expected value: %v
 actual value:   %v`, e.key, e.oldValue, e.newValue)
 }
 
-func NewConsistencyCheck(ctx context.Context, d *schema.ResourceData, meta interface{}, r *schema.Resource) *consistencyCheck {
+// NewConsistencyCheck returns the consistency check for the resource, creating it if needed.
+// ignoredAttrs are attributes that may legitimately differ right after a write, e.g. server-defaulted values.
+// They and any of their nested attributes are skipped by CheckState.
+func NewConsistencyCheck(ctx context.Context, d *schema.ResourceData, meta interface{}, r *schema.Resource, ignoredAttrs ...string) *consistencyCheck {
 	emptyState := isEmptyState(d)
 	if *emptyState {
 		return &consistencyCheck{isEmptyState: emptyState}
@@ -76,6 +80,7 @@ func NewConsistencyCheck(ctx context.Context, d *schema.ResourceData, meta inter
 		originalState: originalState,
 		meta:          meta,
 		isEmptyState:  emptyState,
+		ignoredAttrs:  ignoredAttrs,
 	}
 	mccMutex.Lock()
 	mcc[d.Id()] = cc
@@ -200,6 +205,15 @@ func (c *consistencyCheck) isComputed(key string) bool {
 	return resourceSchema[k].Computed
 }
 
+func (c *consistencyCheck) isIgnored(key string) bool {
+	for _, attr := range c.ignoredAttrs {
+		if key == attr || strings.HasPrefix(key, attr+".") {
+			return true
+		}
+	}
+	return false
+}
+
 func (c *consistencyCheck) CheckState() *resource.RetryError {
 	if c.isEmptyState == nil {
 		panic("consistencyCheck must be initialized with NewConsistencyCheck")
@@ -220,7 +234,7 @@ func (c *consistencyCheck) CheckState() *resource.RetryError {
 	diff, _ := c.r.SimpleDiff(c.ctx, c.d.State(), resourceConfig, c.meta)
 	if diff != nil && len(diff.Attributes) > 0 {
 		for k, v := range diff.Attributes {
-			if strings.HasSuffix(k, "#") {
+			if strings.HasSuffix(k, "#") || c.isIgnored(k) {
 				continue
 			}
 			vTemp := v.Old
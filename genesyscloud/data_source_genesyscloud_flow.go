@@ -3,6 +3,7 @@ package genesyscloud
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -13,7 +14,7 @@ import (
 
 func dataSourceFlow() *schema.Resource {
 	return &schema.Resource{
-		Description: "Data source for Genesys Cloud Flows. Select a flow by name.",
+		Description: "Data source for Genesys Cloud Flows. Select a flow by name and optionally type.",
 		ReadContext: readWithPooledClient(dataSourceFlowRead),
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -21,6 +22,11 @@ func dataSourceFlow() *schema.Resource {
 				Type:        schema.TypeString,
 				Required:    true,
 			},
+			"type": {
+				Description: "Flow type, e.g. inboundcall, inboundchat or inqueuecall. Only needed when flows of different types share a name.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
 		},
 	}
 }
@@ -30,12 +36,18 @@ func dataSourceFlowRead(ctx context.Context, d *schema.ResourceData, m interface
 	archAPI := platformclientv2.NewArchitectApiWithConfig(sdkConfig)
 
 	name := d.Get("name").(string)
+	flowType := d.Get("type").(string)
+
+	var flowTypes []string
+	if flowType != "" {
+		flowTypes = []string{strings.ToLower(flowType)}
+	}
 
 	// Query flow by name. Retry in case search has not yet indexed the flow.
 	return withRetries(ctx, 5*time.Second, func() *resource.RetryError {
 		const pageSize = 100
 		for pageNum := 1; ; pageNum++ {
-			flows, _, getErr := archAPI.GetFlows(nil, pageNum, pageSize, "", "", nil, name, "", "", "", "", "", "", "", false, false, "", "", nil)
+			flows, _, getErr := archAPI.GetFlows(flowTypes, pageNum, pageSize, "", "", nil, name, "", "", "", "", "", "", "", false, false, "", "", nil)
 			if getErr != nil {
 				return resource.NonRetryableError(fmt.Errorf("Error requesting flow %s: %s", name, getErr))
 			}
@@ -45,7 +57,7 @@ func dataSourceFlowRead(ctx context.Context, d *schema.ResourceData, m interface
 			}
 
 			for _, entity := range *flows.Entities {
-				if *entity.Name == name {
+				if *entity.Name == name && (flowType == "" || (entity.VarType != nil && strings.EqualFold(*entity.VarType, flowType))) {
 					d.SetId(*entity.Id)
 					return nil
 				}
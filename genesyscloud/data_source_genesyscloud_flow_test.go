@@ -38,6 +38,22 @@ func TestAccDataSourceFlow(t *testing.T) {
 					resource.TestCheckResourceAttrPair("data.genesyscloud_flow."+flowDataSource, "id", "genesyscloud_flow."+flowResource, "id"),
 				),
 			},
+			{
+				Config: generateFlowResource(
+					flowResource,
+					filePath,
+					inboundcallConfig,
+					false,
+				) + generateFlowDataSourceWithType(
+					flowDataSource,
+					"genesyscloud_flow."+flowResource,
+					flowName,
+					"inboundcall",
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.genesyscloud_flow."+flowDataSource, "id", "genesyscloud_flow."+flowResource, "id"),
+				),
+			},
 		},
 	})
 }
@@ -52,3 +68,16 @@ func generateFlowDataSource(
 	}
 	`, resourceID, name, dependsOn)
 }
+
+func generateFlowDataSourceWithType(
+	resourceID,
+	dependsOn,
+	name,
+	flowType string) string {
+	return fmt.Sprintf(`data "genesyscloud_flow" "%s" {
+		name = "%s"
+		type = "%s"
+		depends_on = [%s]
+	}
+	`, resourceID, name, flowType, dependsOn)
+}
@@ -12,14 +12,30 @@ import (
 
 func dataSourceJourneySegment() *schema.Resource {
 	return &schema.Resource{
-		Description: "Data source for Genesys Cloud Journey Segment. Select a journey segment by name",
+		Description: "Data source for Genesys Cloud Journey Segment. Select a journey segment by name. Active and inactive segments are searched, and an error is returned if more than one segment has the name.",
 		ReadContext: readWithPooledClient(dataSourceJourneySegmentRead),
 		Schema: map[string]*schema.Schema{
 			"name": {
-				Description: "Journey Segment name.",
+				Description: "Journey Segment display name.",
 				Type:        schema.TypeString,
 				Required:    true,
 			},
+			"filter": {
+				Description: "Additional properties that must match, keyed by the API property name, e.g. `{ scope = \"Session\" }`. Nested properties can be matched with dot separated keys.",
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"scope": {
+				Description: "The target entity of the segment (Session | Customer).",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"should_display_to_agent": {
+				Description: "Whether the segment is displayed to agents.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
 		},
 	}
 }
@@ -29,29 +45,55 @@ func dataSourceJourneySegmentRead(ctx context.Context, d *schema.ResourceData, m
 	journeyApi := platformclientv2.NewJourneyApiWithConfig(sdkConfig)
 
 	name := d.Get("name").(string)
+	filter := d.Get("filter").(map[string]interface{})
 
 	return withRetries(ctx, 15*time.Second, func() *resource.RetryError {
-		pageCount := 1 // Needed because of broken journey common paging
-		for pageNum := 1; pageNum <= pageCount; pageNum++ {
-			const pageSize = 100
-			journeySegments, _, getErr := journeyApi.GetJourneySegments("", pageSize, pageNum, true, nil, nil, "")
-			if getErr != nil {
-				return resource.NonRetryableError(fmt.Errorf("failed to get page of journey segments: %v", getErr))
-			}
+		var matchingIds []string
+		var matchingSegment platformclientv2.Journeysegment
+		// The API only returns active or inactive segments per query, so search both
+		for _, isActive := range []bool{true, false} {
+			pageCount := 1 // Needed because of broken journey common paging
+			for pageNum := 1; pageNum <= pageCount; pageNum++ {
+				const pageSize = 100
+				journeySegments, _, getErr := journeyApi.GetJourneySegments("", pageSize, pageNum, isActive, nil, nil, "")
+				if getErr != nil {
+					return resource.NonRetryableError(fmt.Errorf("failed to get page of journey segments: %v", getErr))
+				}
 
-			if journeySegments.Entities == nil || len(*journeySegments.Entities) == 0 {
-				return resource.RetryableError(fmt.Errorf("no journey segment found with name %s", name))
-			}
+				if journeySegments.Entities == nil || len(*journeySegments.Entities) == 0 {
+					break
+				}
+
+				for _, journeySegment := range *journeySegments.Entities {
+					if journeySegment.DisplayName != nil && *journeySegment.DisplayName == name {
+						matches, err := entityMatchesFilter(journeySegment, filter)
+						if err != nil {
+							return resource.NonRetryableError(err)
+						}
+						if matches {
+							matchingIds = append(matchingIds, *journeySegment.Id)
+							matchingSegment = journeySegment
+						}
+					}
+				}
 
-			for _, journeySegment := range *journeySegments.Entities {
-				if journeySegment.DisplayName != nil && *journeySegment.DisplayName == name {
-					d.SetId(*journeySegment.Id)
-					return nil
+				if journeySegments.PageCount == nil {
+					// No page count returned, so treat this as the last page
+					break
 				}
+				pageCount = *journeySegments.PageCount
 			}
+		}
 
-			pageCount = *journeySegments.PageCount
+		if len(matchingIds) == 0 {
+			return resource.RetryableError(fmt.Errorf("no journey segment found with name %s", name))
+		}
+		if len(matchingIds) > 1 {
+			return resource.NonRetryableError(fmt.Errorf("found %d journey segments with name %s (%v). Names must be unique to select a journey segment", len(matchingIds), name, matchingIds))
 		}
-		return resource.RetryableError(fmt.Errorf("no journey segment found with name %s", name))
+		d.SetId(matchingIds[0])
+		setNillableValue(d, "scope", matchingSegment.Scope)
+		setNillableValue(d, "should_display_to_agent", matchingSegment.ShouldDisplayToAgent)
+		return nil
 	})
 }
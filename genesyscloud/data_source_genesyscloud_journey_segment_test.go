@@ -10,6 +10,10 @@ func TestAccDataJourneySegmentSession(t *testing.T) {
 	runDataJourneySegmentTestCase(t, "find_by_name")
 }
 
+func TestAccDataJourneySegmentInactive(t *testing.T) {
+	runDataJourneySegmentTestCase(t, "find_inactive_by_name")
+}
+
 func runDataJourneySegmentTestCase(t *testing.T, testCaseName string) {
 	const testType = "data_source"
 	const testSuitName = "journey_segment"
@@ -24,6 +28,8 @@ func runDataJourneySegmentTestCase(t *testing.T, testCaseName string) {
 		Steps: generateTestSteps(testType, testSuitName, testCaseName, resourceName, idPrefix, []resource.TestCheckFunc{
 			resource.ComposeTestCheckFunc(
 				resource.TestCheckResourceAttrPair("data."+testObjectName, "id", testObjectName, "id"),
+				resource.TestCheckResourceAttrPair("data."+testObjectName, "scope", testObjectName, "scope"),
+				resource.TestCheckResourceAttrPair("data."+testObjectName, "should_display_to_agent", testObjectName, "should_display_to_agent"),
 				resource.TestCheckResourceAttr(testObjectName, "display_name", idPrefix+testCaseName+"_to_find"),
 			),
 		}),
@@ -21,6 +21,41 @@ func dataSourceRoutingQueue() *schema.Resource {
 				Type:        schema.TypeString,
 				Required:    true,
 			},
+			"include_members": {
+				Description: "Whether to read the queue's user members into `members`. Members are read with an additional request per 100 members.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"members": {
+				Description: "Users in the queue. Only set when `include_members` is true.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"user_id": {
+							Description: "User ID.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"name": {
+							Description: "User display name.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"ring_num": {
+							Description: "Ring number of the user in the queue.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"routing_status": {
+							Description: "Current routing status of the user.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -32,7 +67,7 @@ func dataSourceRoutingQueueRead(ctx context.Context, d *schema.ResourceData, m i
 	name := d.Get("name").(string)
 
 	// Find first queue name. Retry in case new queue is not yet indexed by search
-	return withRetries(ctx, 15*time.Second, func() *resource.RetryError {
+	diagErr := withRetries(ctx, 15*time.Second, func() *resource.RetryError {
 		for pageNum := 1; ; pageNum++ {
 			const pageSize = 100
 			queues, _, getErr := routingAPI.GetRoutingQueues(pageNum, pageSize, name, "", nil, nil, nil, false)
@@ -52,4 +87,33 @@ func dataSourceRoutingQueueRead(ctx context.Context, d *schema.ResourceData, m i
 			}
 		}
 	})
+	if diagErr != nil {
+		return diagErr
+	}
+
+	if !d.Get("include_members").(bool) {
+		d.Set("members", nil)
+		return nil
+	}
+	members, diagErr := getRoutingQueueMembers(d.Id(), routingAPI, "routingStatus")
+	if diagErr != nil {
+		return diagErr
+	}
+	d.Set("members", flattenQueueDataSourceMembers(members))
+	return nil
+}
+
+func flattenQueueDataSourceMembers(members []platformclientv2.Queuemember) []interface{} {
+	memberList := make([]interface{}, len(members))
+	for i, member := range members {
+		memberMap := make(map[string]interface{})
+		setMapValueIfNotNil(memberMap, "user_id", member.Id)
+		setMapValueIfNotNil(memberMap, "name", member.Name)
+		setMapValueIfNotNil(memberMap, "ring_num", member.RingNumber)
+		if member.RoutingStatus != nil {
+			setMapValueIfNotNil(memberMap, "routing_status", member.RoutingStatus.Status)
+		}
+		memberList[i] = memberMap
+	}
+	return memberList
 }
@@ -50,6 +50,46 @@ func TestAccDataSourceRoutingQueueBasic(t *testing.T) {
 	})
 }
 
+func TestAccDataSourceRoutingQueueIncludeMembers(t *testing.T) {
+	var (
+		queueResource   = "test-queue-members"
+		queueName       = "Terraform Test Queue-" + uuid.NewString()
+		queueDataSource = "test-queue-members-ds"
+		userResource    = "test-queue-member-user"
+		userEmail       = "terraform-" + uuid.NewString() + "@example.com"
+		userName        = "Henry Terraform"
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: generateRoutingQueueResourceBasic(
+					queueResource,
+					queueName,
+					generateMemberBlock("genesyscloud_user."+userResource+".id", "2"),
+				) + generateBasicUserResource(
+					userResource,
+					userEmail,
+					userName,
+				) + fmt.Sprintf(`data "genesyscloud_routing_queue" "%s" {
+		name            = genesyscloud_routing_queue.%s.name
+		include_members = true
+		depends_on      = [genesyscloud_routing_queue.%s]
+	}
+	`, queueDataSource, queueResource, queueResource),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.genesyscloud_routing_queue."+queueDataSource, "members.#", "1"),
+					resource.TestCheckResourceAttrPair("data.genesyscloud_routing_queue."+queueDataSource, "members.0.user_id", "genesyscloud_user."+userResource, "id"),
+					resource.TestCheckResourceAttr("data.genesyscloud_routing_queue."+queueDataSource, "members.0.name", userName),
+					resource.TestCheckResourceAttr("data.genesyscloud_routing_queue."+queueDataSource, "members.0.ring_num", "2"),
+				),
+			},
+		},
+	})
+}
+
 func generateRoutingQueueDataSource(
 	resourceID string,
 	name string,
@@ -3,11 +3,13 @@ package genesyscloud
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func dataSourceTrunkBaseSettings() *schema.Resource {
@@ -20,6 +22,30 @@ func dataSourceTrunkBaseSettings() *schema.Resource {
 				Type:        schema.TypeString,
 				Required:    true,
 			},
+			"trunk_type": {
+				Description:  "Only match trunk base settings of this type, for when names are shared between trunk types.Valid values: EXTERNAL, PHONE, EDGE.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"EXTERNAL", "PHONE", "EDGE"}, false),
+			},
+			"managed": {
+				Description: "Only match trunk base settings whose managed flag equals this value, for when customer trunk base settings share a name with managed defaults.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+			"retry_timeout_seconds": {
+				Description:  "How long to keep retrying when no trunk base settings match, as newly created trunk base settings can take time to become searchable. The provider `retry_timeout_multiplier` also applies.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      60,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"filter": {
+				Description: "Additional properties that must match, keyed by the API property name, e.g. `{ state = \"active\" }`. Nested properties can be matched with dot separated keys.",
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
 		},
 	}
 }
@@ -28,8 +54,13 @@ func dataSourceTrunkBaseSettingsRead(ctx context.Context, d *schema.ResourceData
 	sdkConfig := m.(*providerMeta).ClientConfig
 
 	name := d.Get("name").(string)
+	trunkType := d.Get("trunk_type").(string)
+	managed := getNillableBool(d, "managed")
+	filter := d.Get("filter").(map[string]interface{})
+	retryTimeout := time.Duration(d.Get("retry_timeout_seconds").(int)) * time.Second
 
-	return withRetries(ctx, 15*time.Second, func() *resource.RetryError {
+	return withRetries(ctx, retryTimeout, func() *resource.RetryError {
+		var matchingIds []string
 		for pageNum := 1; ; pageNum++ {
 			const pageSize = 100
 			trunkBaseSettings, _, getErr := getTelephonyProvidersEdgesTrunkbasesettings(sdkConfig, pageNum, pageSize, name)
@@ -39,16 +70,32 @@ func dataSourceTrunkBaseSettingsRead(ctx context.Context, d *schema.ResourceData
 			}
 
 			if trunkBaseSettings.Entities == nil || len(*trunkBaseSettings.Entities) == 0 {
-				return resource.RetryableError(fmt.Errorf("No trunkBaseSettings found with name %s", name))
+				break
 			}
 
 			for _, trunkBaseSetting := range *trunkBaseSettings.Entities {
 				if trunkBaseSetting.Name != nil && *trunkBaseSetting.Name == name &&
-					trunkBaseSetting.State != nil && *trunkBaseSetting.State != "deleted" {
-					d.SetId(*trunkBaseSetting.Id)
-					return nil
+					trunkBaseSetting.State != nil && *trunkBaseSetting.State != "deleted" &&
+					(trunkType == "" || (trunkBaseSetting.TrunkType != nil && *trunkBaseSetting.TrunkType == trunkType)) &&
+					(managed == nil || (trunkBaseSetting.Managed != nil && *trunkBaseSetting.Managed == *managed)) {
+					matches, err := entityMatchesFilter(trunkBaseSetting, filter)
+					if err != nil {
+						return resource.NonRetryableError(err)
+					}
+					if matches {
+						matchingIds = append(matchingIds, *trunkBaseSetting.Id)
+					}
 				}
 			}
 		}
+
+		if len(matchingIds) == 0 {
+			return resource.RetryableError(fmt.Errorf("No trunkBaseSettings found with name %s", name))
+		}
+		if len(matchingIds) > 1 {
+			return resource.NonRetryableError(fmt.Errorf("Found %d trunkBaseSettings with name %s: %s. Set trunk_type or managed, or rename them so only one matches", len(matchingIds), name, strings.Join(matchingIds, ", ")))
+		}
+		d.SetId(matchingIds[0])
+		return nil
 	})
 }
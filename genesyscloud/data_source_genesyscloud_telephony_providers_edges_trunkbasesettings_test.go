@@ -40,6 +40,40 @@ func TestAccDataSourceTrunkBaseSettings(t *testing.T) {
 					resource.TestCheckResourceAttrPair("data.genesyscloud_telephony_providers_edges_trunkbasesettings."+trunkBaseSettingsDataRes, "id", "genesyscloud_telephony_providers_edges_trunkbasesettings."+trunkBaseSettingsRes, "id"),
 				),
 			},
+			{
+				Config: generateTrunkBaseSettingsResourceWithCustomAttrs(
+					trunkBaseSettingsRes,
+					name,
+					description,
+					trunkMetaBaseId,
+					trunkType,
+					managed,
+				) + generateTrunkBaseSettingsDataSourceWithType(
+					trunkBaseSettingsDataRes,
+					name,
+					trunkType,
+					"genesyscloud_telephony_providers_edges_trunkbasesettings."+trunkBaseSettingsRes),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.genesyscloud_telephony_providers_edges_trunkbasesettings."+trunkBaseSettingsDataRes, "id", "genesyscloud_telephony_providers_edges_trunkbasesettings."+trunkBaseSettingsRes, "id"),
+				),
+			},
+			{
+				Config: generateTrunkBaseSettingsResourceWithCustomAttrs(
+					trunkBaseSettingsRes,
+					name,
+					description,
+					trunkMetaBaseId,
+					trunkType,
+					managed,
+				) + generateTrunkBaseSettingsDataSourceWithManaged(
+					trunkBaseSettingsDataRes,
+					name,
+					managed,
+					"genesyscloud_telephony_providers_edges_trunkbasesettings."+trunkBaseSettingsRes),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.genesyscloud_telephony_providers_edges_trunkbasesettings."+trunkBaseSettingsDataRes, "id", "genesyscloud_telephony_providers_edges_trunkbasesettings."+trunkBaseSettingsRes, "id"),
+				),
+			},
 		},
 	})
 }
@@ -56,3 +90,29 @@ func generateTrunkBaseSettingsDataSource(
 	}
 	`, resourceID, name, dependsOnResource)
 }
+
+func generateTrunkBaseSettingsDataSourceWithType(
+	resourceID string,
+	name string,
+	trunkType string,
+	dependsOnResource string) string {
+	return fmt.Sprintf(`data "genesyscloud_telephony_providers_edges_trunkbasesettings" "%s" {
+		name = "%s"
+		trunk_type = "%s"
+		depends_on=[%s]
+	}
+	`, resourceID, name, trunkType, dependsOnResource)
+}
+
+func generateTrunkBaseSettingsDataSourceWithManaged(
+	resourceID string,
+	name string,
+	managed bool,
+	dependsOnResource string) string {
+	return fmt.Sprintf(`data "genesyscloud_telephony_providers_edges_trunkbasesettings" "%s" {
+		name = "%s"
+		managed = %v
+		depends_on=[%s]
+	}
+	`, resourceID, name, managed, dependsOnResource)
+}
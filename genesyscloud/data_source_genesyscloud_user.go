@@ -3,6 +3,7 @@ package genesyscloud
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -13,7 +14,7 @@ import (
 
 func dataSourceUser() *schema.Resource {
 	return &schema.Resource{
-		Description: "Data source for Genesys Cloud Users. Select a user by email or name.",
+		Description: "Data source for Genesys Cloud Users. Select a user by email, name or both. An error is returned if more than one user matches.",
 		ReadContext: readWithPooledClient(dataSourceUserRead),
 		Schema: map[string]*schema.Schema{
 			"email": {
@@ -39,39 +40,63 @@ func dataSourceUserRead(ctx context.Context, d *schema.ResourceData, m interface
 	emailField := "email"
 	nameField := "name"
 
-	searchCriteria := platformclientv2.Usersearchcriteria{
-		VarType: &exactSearchType,
-	}
+	// When both email and name are set, a user must match both
+	var searchCriteria []platformclientv2.Usersearchcriteria
 	if email, ok := d.GetOk("email"); ok {
 		emailStr := email.(string)
-		searchCriteria.Fields = &[]string{emailField}
-		searchCriteria.Value = &emailStr
-	} else if name, ok := d.GetOk("name"); ok {
+		searchCriteria = append(searchCriteria, platformclientv2.Usersearchcriteria{
+			VarType: &exactSearchType,
+			Fields:  &[]string{emailField},
+			Value:   &emailStr,
+		})
+	}
+	if name, ok := d.GetOk("name"); ok {
 		nameStr := name.(string)
-		searchCriteria.Fields = &[]string{nameField}
-		searchCriteria.Value = &nameStr
-	} else {
+		searchCriteria = append(searchCriteria, platformclientv2.Usersearchcriteria{
+			VarType: &exactSearchType,
+			Fields:  &[]string{nameField},
+			Value:   &nameStr,
+		})
+	}
+	if len(searchCriteria) == 0 {
 		return diag.Errorf("No user search field specified")
 	}
+	searchDescription := fmt.Sprintf("email %q name %q", d.Get("email").(string), d.Get("name").(string))
 
 	// Retry in case user is not yet indexed
 	return withRetries(ctx, 15*time.Second, func() *resource.RetryError {
-		users, _, getErr := usersAPI.PostUsersSearch(platformclientv2.Usersearchrequest{
-			SortBy:    &emailField,
-			SortOrder: &sortOrderAsc,
-			Query:     &[]platformclientv2.Usersearchcriteria{searchCriteria},
-		})
-		if getErr != nil {
-			return resource.NonRetryableError(fmt.Errorf("Error requesting users: %s", getErr))
-		}
+		var matchingIds []string
+		pageSize := 100
+		for pageNum := 1; ; pageNum++ {
+			users, _, getErr := usersAPI.PostUsersSearch(platformclientv2.Usersearchrequest{
+				SortBy:     &emailField,
+				SortOrder:  &sortOrderAsc,
+				PageSize:   &pageSize,
+				PageNumber: &pageNum,
+				Query:      &searchCriteria,
+			})
+			if getErr != nil {
+				return resource.NonRetryableError(fmt.Errorf("Error requesting users: %s", getErr))
+			}
 
-		if users.Results == nil || len(*users.Results) == 0 {
-			return resource.RetryableError(fmt.Errorf("No users found with search criteria %v", searchCriteria))
+			if users.Results == nil || len(*users.Results) == 0 {
+				break
+			}
+			for _, user := range *users.Results {
+				matchingIds = append(matchingIds, *user.Id)
+			}
+			if users.PageCount == nil || pageNum >= *users.PageCount {
+				break
+			}
 		}
 
-		// Select first user in the list
-		user := (*users.Results)[0]
-		d.SetId(*user.Id)
+		if len(matchingIds) == 0 {
+			return resource.RetryableError(fmt.Errorf("No users found with %s", searchDescription))
+		}
+		if len(matchingIds) > 1 {
+			return resource.NonRetryableError(fmt.Errorf("Found %d users with %s: %s. Set both email and name so only one user matches", len(matchingIds), searchDescription, strings.Join(matchingIds, ", ")))
+		}
+		d.SetId(matchingIds[0])
 		return nil
 	})
 }
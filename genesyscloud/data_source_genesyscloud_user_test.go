@@ -52,6 +52,22 @@ func TestAccDataSourceUser(t *testing.T) {
 					resource.TestCheckResourceAttrPair("data.genesyscloud_user."+userDataSource, "id", "genesyscloud_user."+userResource, "id"),
 				),
 			},
+			{
+				// Search by email and name
+				Config: generateBasicUserResource(
+					userResource,
+					userEmail,
+					userName,
+				) + generateUserDataSource(
+					userDataSource,
+					"genesyscloud_user."+userResource+".email",
+					"genesyscloud_user."+userResource+".name",
+					"genesyscloud_user."+userResource,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.genesyscloud_user."+userDataSource, "id", "genesyscloud_user."+userResource, "id"),
+				),
+			},
 		},
 	})
 }
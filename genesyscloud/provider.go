@@ -73,6 +73,43 @@ func New(version string) func() *schema.Provider {
 					Description:  "Max number of OAuth tokens in the token pool. Can be set with the `GENESYSCLOUD_TOKEN_POOL_SIZE` environment variable.",
 					ValidateFunc: validation.IntBetween(1, 20),
 				},
+				"retry_timeout_multiplier": {
+					Type:         schema.TypeFloat,
+					Optional:     true,
+					DefaultFunc:  schema.EnvDefaultFunc("GENESYSCLOUD_RETRY_TIMEOUT_MULTIPLIER", 1.0),
+					Description:  "Multiplier applied to the timeouts of retried reads, deletes and lookups. Increase it for slow or throttled orgs. Can be set with the `GENESYSCLOUD_RETRY_TIMEOUT_MULTIPLIER` environment variable.",
+					ValidateFunc: validation.FloatBetween(0.1, 20),
+				},
+				"disable_home_division_fallback": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					DefaultFunc: schema.EnvDefaultFunc("GENESYSCLOUD_DISABLE_HOME_DIVISION_FALLBACK", false),
+					Description: "Returns an error instead of falling back to the home division when a routing queue's `division_id` is set to an empty string. Can be set with the `GENESYSCLOUD_DISABLE_HOME_DIVISION_FALLBACK` environment variable.",
+				},
+				"validate_queue_flow_types": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					DefaultFunc: schema.EnvDefaultFunc("GENESYSCLOUD_VALIDATE_QUEUE_FLOW_TYPES", false),
+					Description: "Validates during plan that in-queue flow IDs on routing queues reference flows of the matching in-queue type. Can be set with the `GENESYSCLOUD_VALIDATE_QUEUE_FLOW_TYPES` environment variable.",
+				},
+				"batch_division_assignments": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					DefaultFunc: schema.EnvDefaultFunc("GENESYSCLOUD_BATCH_DIVISION_ASSIGNMENTS", false),
+					Description: "Groups division changes for routing queues made during the same apply, moving the queues for each division with a single request. This reduces API calls and throttling for large deployments, but adds a short delay to each queue division change. Can be set with the `GENESYSCLOUD_BATCH_DIVISION_ASSIGNMENTS` environment variable.",
+				},
+				"log_sdk_payloads": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					DefaultFunc: schema.EnvDefaultFunc("GENESYSCLOUD_LOG_SDK_PAYLOADS", false),
+					Description: "Logs the JSON request body of failed creates and updates for debugging. Payloads may contain sensitive configuration, so this is disabled by default. Can be set with the `GENESYSCLOUD_LOG_SDK_PAYLOADS` environment variable.",
+				},
+				"validate_queue_bullseye_skills": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					DefaultFunc: schema.EnvDefaultFunc("GENESYSCLOUD_VALIDATE_QUEUE_BULLSEYE_SKILLS", false),
+					Description: "Validates during plan that skill IDs in routing queue `bullseye_rings.skills_to_remove` reference existing skills. Can be set with the `GENESYSCLOUD_VALIDATE_QUEUE_BULLSEYE_SKILLS` environment variable.",
+				},
 			},
 			ResourcesMap: map[string]*schema.Resource{
 				"genesyscloud_architect_datatable":                         resourceArchitectDatatable(),
@@ -204,9 +241,14 @@ func New(version string) func() *schema.Provider {
 }
 
 type providerMeta struct {
-	Version      string
-	ClientConfig *platformclientv2.Configuration
-	Domain       string
+	Version                     string
+	ClientConfig                *platformclientv2.Configuration
+	Domain                      string
+	ValidateQueueFlowTypes      bool
+	ValidateQueueBullseyeSkills bool
+	DisableHomeDivisionFallback bool
+	RetryTimeoutMultiplier      float64
+	DivisionBatcher             *divisionBatcher
 }
 
 func configure(version string) schema.ConfigureContextFunc {
@@ -230,10 +272,20 @@ func configure(version string) schema.ConfigureContextFunc {
 				return nil, err
 			}
 		}
+		var batcher *divisionBatcher
+		if data.Get("batch_division_assignments").(bool) {
+			batcher = newDivisionBatcher(divisionBatchWindow)
+		}
+
 		return &providerMeta{
-			Version:      version,
-			ClientConfig: platformclientv2.GetDefaultConfiguration(),
-			Domain:       getRegionDomain(data.Get("aws_region").(string)),
+			Version:                     version,
+			ClientConfig:                platformclientv2.GetDefaultConfiguration(),
+			Domain:                      getRegionDomain(data.Get("aws_region").(string)),
+			ValidateQueueFlowTypes:      data.Get("validate_queue_flow_types").(bool),
+			ValidateQueueBullseyeSkills: data.Get("validate_queue_bullseye_skills").(bool),
+			DisableHomeDivisionFallback: data.Get("disable_home_division_fallback").(bool),
+			RetryTimeoutMultiplier:      data.Get("retry_timeout_multiplier").(float64),
+			DivisionBatcher:             batcher,
 		}, nil
 	}
 }
@@ -292,6 +344,7 @@ func initClientConfig(data *schema.ResourceData, version string, config *platfor
 		config.LoggingConfiguration.SetLogFilePath("sdk_debug.log")
 	}
 	config.AddDefaultHeader("User-Agent", "GC Terraform Provider/"+version)
+	logPayloads := data.Get("log_sdk_payloads").(bool)
 	config.RetryConfiguration = &platformclientv2.RetryConfiguration{
 		RetryWaitMin: time.Second * 1,
 		RetryWaitMax: time.Second * 30,
@@ -300,10 +353,16 @@ func initClientConfig(data *schema.ResourceData, version string, config *platfor
 			if count > 0 && request != nil {
 				log.Printf("Retry #%d for %s %s%s", count, request.Method, request.Host, request.RequestURI)
 			}
+			if logPayloads {
+				captureWritePayload(request)
+			}
 		},
 		ResponseLogHook: func(response *http.Response) {
 			if response.StatusCode < http.StatusOK || response.StatusCode >= http.StatusMultipleChoices {
 				log.Printf("Response %s", response.Status)
+				if logPayloads {
+					logFailedWritePayload(response)
+				}
 			}
 		},
 	}
@@ -20,6 +20,12 @@ type ResourceMeta struct {
 
 	// Prefix to add to the ID when reading state
 	IdPrefix string
+
+	// Division the resource belongs to. Only set for resource types whose list entities include a division
+	DivisionId string
+
+	// Type of the resource, e.g. a flow's type. Only set for resource types whose data source can also select by type
+	Type string
 }
 
 // ResourceIDMetaMap is a map of IDs to ResourceMeta
@@ -36,6 +42,17 @@ type RefAttrSettings struct {
 
 	// Values that may be set that should not be treated as IDs
 	AltValues []string
+
+	// Data source that can look up the referenced type by name, e.g. 'genesyscloud_flow'.
+	// When the referenced type is not being exported, references are written as lookups with this data source
+	// instead of being removed, so the config can be applied to orgs where the IDs differ
+	DataSourceType string
+
+	// IDs to metadata of the referenced type. This is loaded at export time when DataSourceType is used
+	dataSourceMetas ResourceIDMetaMap
+
+	// Data source lookups used by the export, keyed by label. This is set while resolving references
+	dataSourceRefs map[string]jsonMap
 }
 
 type JsonEncodeRefAttr struct {
@@ -80,6 +97,10 @@ type ResourceExporter struct {
 
 	// Attributes that are jsonencode objects, and that contain nested RefAttrs
 	EncodedRefAttrs map[*JsonEncodeRefAttr]*RefAttrSettings
+
+	// List of division IDs to limit the export to. This is set by the export configuration.
+	// Resources without a DivisionId in their ResourceMeta are not division-aware and are never filtered out
+	DivisionFilter []string
 }
 
 func (r *ResourceExporter) loadSanitizedResourceMap(ctx context.Context, name string, filter []string) diag.Diagnostics {
@@ -92,6 +113,10 @@ func (r *ResourceExporter) loadSanitizedResourceMap(ctx context.Context, name st
 		result = filterResources(result, name, filter)
 	}
 
+	if len(r.DivisionFilter) > 0 {
+		result = filterResourcesByDivision(result, r.DivisionFilter)
+	}
+
 	r.SanitizedResourceMap = result
 	sanitizeResourceNames(r.SanitizedResourceMap)
 	return nil
@@ -117,6 +142,16 @@ func filterResources(result ResourceIDMetaMap, name string, filter []string) Res
 	return newResult
 }
 
+func filterResourcesByDivision(result ResourceIDMetaMap, divisionIds []string) ResourceIDMetaMap {
+	newResult := make(ResourceIDMetaMap)
+	for k, v := range result {
+		if v.DivisionId == "" || stringInSlice(v.DivisionId, divisionIds) {
+			newResult[k] = v
+		}
+	}
+	return newResult
+}
+
 func (r *ResourceExporter) getRefAttrSettings(attribute string) *RefAttrSettings {
 	if r.RefAttrs == nil {
 		return nil
@@ -33,7 +33,11 @@ func getAllFlows(ctx context.Context, clientConfig *platformclientv2.Configurati
 		}
 
 		for _, flow := range *flows.Entities {
-			resources[*flow.Id] = &ResourceMeta{Name: *flow.Name}
+			resourceMeta := &ResourceMeta{Name: *flow.Name}
+			if flow.VarType != nil {
+				resourceMeta.Type = strings.ToLower(*flow.VarType)
+			}
+			resources[*flow.Id] = resourceMeta
 		}
 	}
 
@@ -139,7 +139,7 @@ func createJourneyOutcome(ctx context.Context, d *schema.ResourceData, meta inte
 	log.Printf("Creating journey outcome %s", *journeyOutcome.DisplayName)
 	result, resp, err := journeyApi.PostJourneyOutcomes(*journeyOutcome)
 	if err != nil {
-		return diag.Errorf("failed to create journey outcome %s: %s\n(input: %+v)\n(resp: %s)", *journeyOutcome.DisplayName, err, *journeyOutcome, resp.RawBody)
+		return diag.Errorf("failed to create journey outcome %s: %s\n(resp: %s)", *journeyOutcome.DisplayName, err, resp.RawBody)
 	}
 
 	d.SetId(*result.Id)
@@ -186,7 +186,7 @@ func updateJourneyOutcome(ctx context.Context, d *schema.ResourceData, meta inte
 		patchOutcome.Version = journeyOutcome.Version
 		_, resp, patchErr := journeyApi.PatchJourneyOutcome(d.Id(), *patchOutcome)
 		if patchErr != nil {
-			return resp, diag.Errorf("Error updating journey outcome %s: %s\n(input: %+v)\n(resp: %s)", *patchOutcome.DisplayName, patchErr, *patchOutcome, resp.RawBody)
+			return resp, diag.Errorf("Error updating journey outcome %s: %s\n(resp: %s)", *patchOutcome.DisplayName, patchErr, resp.RawBody)
 		}
 		return resp, nil
 	})
@@ -2,9 +2,13 @@ package genesyscloud
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
+	"net/http"
+	"net/url"
 	"regexp"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -34,13 +38,10 @@ var (
 			Optional:    true,
 		},
 		"color": {
-			Description: "The hexadecimal color value of the segment.",
-			Type:        schema.TypeString,
-			Required:    true,
-			ValidateFunc: validation.StringMatch(func() *regexp.Regexp {
-				r, _ := regexp.Compile("^#[a-fA-F\\d]{6}$")
-				return r
-			}(), ""),
+			Description:      "The hexadecimal color value of the segment in the form #RRGGBB.",
+			Type:             schema.TypeString,
+			Required:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringMatch(regexp.MustCompile("^#[a-fA-F\\d]{6}$"), "must be a hexadecimal color in the form #RRGGBB, e.g. #008000")),
 		},
 		"scope": {
 			Description:  "The target entity that a segment applies to.Valid values: Session, Customer.",
@@ -60,14 +61,14 @@ var (
 			Type:        schema.TypeSet,
 			Optional:    true,
 			MaxItems:    1,
-			Elem:        contextResource,
+			Elem:        segmentContextResource,
 		},
 		"journey": {
 			Description: "The pattern of rules defining the segment.",
 			Type:        schema.TypeSet,
 			Optional:    true,
 			MaxItems:    1,
-			Elem:        journeyResource,
+			Elem:        segmentJourneyResource,
 		},
 		"external_segment": {
 			Description: "Details of an entity corresponding to this segment in an external system.",
@@ -215,10 +216,7 @@ var (
 				Required:    true,
 				ValidateFunc: validation.Any(
 					validation.StringInSlice([]string{"eventName", "page.url", "page.title", "page.hostname", "page.domain", "page.fragment", "page.keywords", "page.pathname", "searchQuery", "page.queryString"}, false),
-					validation.StringMatch(func() *regexp.Regexp {
-						r, _ := regexp.Compile("attributes\\..*\\.value")
-						return r
-					}(), ""),
+					validation.StringMatch(regexp.MustCompile("^attributes\\..+\\.value$"), "custom attribute keys must be in the form attributes.<name>.value"),
 				),
 			},
 			"values": {
@@ -242,30 +240,65 @@ var (
 	}
 )
 
+// Segment criteria default the operator to equal. Outcomes share the criteria schemas without the default,
+// so segments use copies of the context and journey schemas with their own criteria
+var (
+	segmentContextResource = replaceNestedElem(contextResource, "patterns",
+		replaceNestedElem(contextPatternResource, "criteria", criteriaWithDefaultOperator(contextCriteriaResource)))
+	segmentJourneyResource = replaceNestedElem(journeyResource, "patterns",
+		replaceNestedElem(journeyPatternResource, "criteria", criteriaWithDefaultOperator(journeyCriteriaResource)))
+)
+
+// Returns a copy of the resource with the nested block at key using elem as its schema
+func replaceNestedElem(r *schema.Resource, key string, elem *schema.Resource) *schema.Resource {
+	resourceSchema := make(map[string]*schema.Schema, len(r.Schema))
+	for k, v := range r.Schema {
+		resourceSchema[k] = v
+	}
+	nested := *r.Schema[key]
+	nested.Elem = elem
+	resourceSchema[key] = &nested
+	return &schema.Resource{Schema: resourceSchema}
+}
+
+func criteriaWithDefaultOperator(criteria *schema.Resource) *schema.Resource {
+	criteriaSchema := make(map[string]*schema.Schema, len(criteria.Schema))
+	for k, v := range criteria.Schema {
+		criteriaSchema[k] = v
+	}
+	operator := *criteria.Schema["operator"]
+	operator.Default = "equal"
+	criteriaSchema["operator"] = &operator
+	return &schema.Resource{Schema: criteriaSchema}
+}
+
 func getAllJourneySegments(_ context.Context, clientConfig *platformclientv2.Configuration) (ResourceIDMetaMap, diag.Diagnostics) {
-	resources := make(ResourceIDMetaMap)
 	journeyApi := platformclientv2.NewJourneyApiWithConfig(clientConfig)
 
-	pageCount := 1 // Needed because of broken journey common paging
-	for pageNum := 1; pageNum <= pageCount; pageNum++ {
+	return getAllPagesParallel(func(pageNum int) (ResourceIDMetaMap, int, diag.Diagnostics) {
+		resources := make(ResourceIDMetaMap)
+
 		const pageSize = 100
 		journeySegments, _, getErr := journeyApi.GetJourneySegments("", pageSize, pageNum, true, nil, nil, "")
 		if getErr != nil {
-			return nil, diag.Errorf("Failed to get page of journey segments: %v", getErr)
+			return nil, 0, diag.Errorf("Failed to get page of journey segments: %v", getErr)
 		}
 
 		if journeySegments.Entities == nil || len(*journeySegments.Entities) == 0 {
-			break
+			return resources, 0, nil
 		}
 
 		for _, journeySegment := range *journeySegments.Entities {
+			// Journey segments do not belong to a division, so a division filter on the export does not apply to them
 			resources[*journeySegment.Id] = &ResourceMeta{Name: *journeySegment.DisplayName}
 		}
 
-		pageCount = *journeySegments.PageCount
-	}
-
-	return resources, nil
+		pageCount := 1 // Needed because of broken journey common paging
+		if journeySegments.PageCount != nil {
+			pageCount = *journeySegments.PageCount
+		}
+		return resources, pageCount, nil
+	})
 }
 
 func journeySegmentExporter() *ResourceExporter {
@@ -285,11 +318,23 @@ func resourceJourneySegment() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: customizeJourneySegmentRulesDiff,
 		SchemaVersion: 1,
 		Schema:        journeySegmentSchema,
 	}
 }
 
+// The API rejects segments without any rules, so at least one of context or journey is required.
+// Customer scope segments defined by an external segment have no rules of their own.
+func customizeJourneySegmentRulesDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	for _, attr := range []string{"context", "journey", "external_segment"} {
+		if set, ok := diff.Get(attr).(*schema.Set); ok && set.Len() > 0 {
+			return nil
+		}
+	}
+	return fmt.Errorf("journey segment %s must have a context or journey block", diff.Get("display_name").(string))
+}
+
 func createJourneySegment(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sdkConfig := meta.(*providerMeta).ClientConfig
 	journeyApi := platformclientv2.NewJourneyApiWithConfig(sdkConfig)
@@ -298,7 +343,7 @@ func createJourneySegment(ctx context.Context, d *schema.ResourceData, meta inte
 	log.Printf("Creating journey segment %s", *journeySegment.DisplayName)
 	result, resp, err := journeyApi.PostJourneySegments(*journeySegment)
 	if err != nil {
-		return diag.Errorf("failed to create journey segment %s: %s\n(input: %+v)\n(resp: %s)", *journeySegment.DisplayName, err, *journeySegment, resp.RawBody)
+		return diag.Errorf("failed to create journey segment %s: %s\n(resp: %s)", *journeySegment.DisplayName, err, getBody(resp))
 	}
 
 	d.SetId(*result.Id)
@@ -333,28 +378,54 @@ func updateJourneySegment(ctx context.Context, d *schema.ResourceData, meta inte
 	sdkConfig := meta.(*providerMeta).ClientConfig
 	journeyApi := platformclientv2.NewJourneyApiWithConfig(sdkConfig)
 	patchSegment := buildSdkPatchSegment(d)
+	nullAttrs := buildJourneySegmentClearedAttrs(d, patchSegment)
 
 	log.Printf("Updating journey segment %s", d.Id())
-	diagErr := retryWhen(isVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+	diagErr := patchJourneySegmentWithLatestVersion(d.Id(), patchSegment,
+		func() (*int, *platformclientv2.APIResponse, error) {
+			journeySegment, resp, getErr := journeyApi.GetJourneySegment(d.Id())
+			if getErr != nil {
+				return nil, resp, getErr
+			}
+			return journeySegment.Version, resp, nil
+		},
+		func() (*platformclientv2.APIResponse, error) {
+			if len(nullAttrs) > 0 {
+				return sdkPatchJourneySegment(d.Id(), patchSegment, nullAttrs, journeyApi)
+			}
+			_, resp, patchErr := journeyApi.PatchJourneySegment(d.Id(), *patchSegment)
+			return resp, patchErr
+		})
+	if diagErr != nil {
+		return diagErr
+	}
+
+	log.Printf("Updated journey segment %s", d.Id())
+	return readJourneySegment(ctx, d, meta)
+}
+
+// Patches the segment with its current version. The version is read again before each attempt,
+// so a patch that fails because the segment changed after the read is retried with the newer version.
+func patchJourneySegmentWithLatestVersion(
+	segmentId string,
+	patchSegment *platformclientv2.Patchsegment,
+	getVersion func() (*int, *platformclientv2.APIResponse, error),
+	patch func() (*platformclientv2.APIResponse, error),
+) diag.Diagnostics {
+	return retryWhen(isVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
 		// Get current journey segment version
-		journeySegment, resp, getErr := journeyApi.GetJourneySegment(d.Id())
+		version, resp, getErr := getVersion()
 		if getErr != nil {
-			return resp, diag.Errorf("Failed to read current journey segment %s: %s", d.Id(), getErr)
+			return resp, diag.Errorf("Failed to read current journey segment %s: %s", segmentId, getErr)
 		}
 
-		patchSegment.Version = journeySegment.Version
-		_, resp, patchErr := journeyApi.PatchJourneySegment(d.Id(), *patchSegment)
+		patchSegment.Version = version
+		resp, patchErr := patch()
 		if patchErr != nil {
-			return resp, diag.Errorf("Error updating journey segment %s: %s\n(input: %+v)\n(resp: %s)", *patchSegment.DisplayName, patchErr, *patchSegment, resp.RawBody)
+			return resp, diag.Errorf("Error updating journey segment %s: %s\n(resp: %s)", *patchSegment.DisplayName, patchErr, getBody(resp))
 		}
 		return resp, nil
 	})
-	if diagErr != nil {
-		return diagErr
-	}
-
-	log.Printf("Updated journey segment %s", d.Id())
-	return readJourneySegment(ctx, d, meta)
 }
 
 func deleteJourneySegment(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -383,10 +454,72 @@ func deleteJourneySegment(ctx context.Context, d *schema.ResourceData, meta inte
 	})
 }
 
+// Attributes removed from the config must be sent as explicit nulls to be cleared on the server
+func buildJourneySegmentClearedAttrs(d *schema.ResourceData, patchSegment *platformclientv2.Patchsegment) []string {
+	var nullAttrs []string
+	if d.HasChange("description") && patchSegment.Description == nil {
+		nullAttrs = append(nullAttrs, "description")
+	}
+	if d.HasChange("assignment_expiration_days") && patchSegment.AssignmentExpirationDays == nil {
+		nullAttrs = append(nullAttrs, "assignmentExpirationDays")
+	}
+	return nullAttrs
+}
+
+func sdkPatchJourneySegment(segmentId string, patchSegment *platformclientv2.Patchsegment, nullAttrs []string, api *platformclientv2.JourneyApi) (*platformclientv2.APIResponse, error) {
+	// SDK omits nil values from the request body, so we must manually construct this HTTP request to clear attributes
+	apiClient := &api.Configuration.APIClient
+
+	// create path and map variables
+	path := api.Configuration.BasePath + "/api/v2/journey/segments/{segmentId}"
+	path = strings.Replace(path, "{segmentId}", fmt.Sprintf("%v", segmentId), -1)
+
+	headerParams := make(map[string]string)
+	queryParams := make(map[string]string)
+	formParams := url.Values{}
+	var postFileName string
+	var fileBytes []byte
+
+	// oauth required
+	if api.Configuration.AccessToken != "" {
+		headerParams["Authorization"] = "Bearer " + api.Configuration.AccessToken
+	}
+	// add default headers if any
+	for key := range api.Configuration.DefaultHeader {
+		headerParams[key] = api.Configuration.DefaultHeader[key]
+	}
+
+	headerParams["Content-Type"] = "application/json"
+	headerParams["Accept"] = "application/json"
+
+	patchJson, err := json.Marshal(patchSegment)
+	if err != nil {
+		return nil, err
+	}
+	postBody := make(map[string]interface{})
+	if err := json.Unmarshal(patchJson, &postBody); err != nil {
+		return nil, err
+	}
+	for _, attr := range nullAttrs {
+		postBody[attr] = nil
+	}
+
+	response, err := apiClient.CallAPI(path, http.MethodPatch, postBody, headerParams, queryParams, formParams, postFileName, fileBytes)
+	if err == nil && response.Error != nil {
+		err = fmt.Errorf(response.ErrorMessage)
+	}
+	return response, err
+}
+
 func flattenJourneySegment(d *schema.ResourceData, journeySegment *platformclientv2.Journeysegment) {
 	d.Set("is_active", *journeySegment.IsActive)
 	d.Set("display_name", *journeySegment.DisplayName)
-	setNillableValue(d, "description", journeySegment.Description)
+	// An empty description is never sent to the API and is read back as nil. Set it as "" to match description = "" in the config.
+	if journeySegment.Description != nil {
+		d.Set("description", *journeySegment.Description)
+	} else {
+		d.Set("description", "")
+	}
 	setNillableValue(d, "color", journeySegment.Color)
 	setNillableValue(d, "scope", journeySegment.Scope)
 	setNillableValue(d, "should_display_to_agent", journeySegment.ShouldDisplayToAgent)
@@ -530,7 +663,10 @@ func flattenJourneyPattern(journeyPattern *platformclientv2.Journeypattern) map[
 	setMapValueIfNotNil(journeyPatternMap, "count", journeyPattern.Count)
 	setMapValueIfNotNil(journeyPatternMap, "stream_type", journeyPattern.StreamType)
 	setMapValueIfNotNil(journeyPatternMap, "session_type", journeyPattern.SessionType)
-	setMapValueIfNotNil(journeyPatternMap, "event_name", journeyPattern.EventName)
+	// Empty event names are never sent, so treat them the same as unset
+	if journeyPattern.EventName != nil && *journeyPattern.EventName != "" {
+		journeyPatternMap["event_name"] = *journeyPattern.EventName
+	}
 	return journeyPatternMap
 }
 
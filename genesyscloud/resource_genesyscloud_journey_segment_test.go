@@ -3,6 +3,8 @@ package genesyscloud
 import (
 	"fmt"
 	"log"
+	"net/http"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -24,6 +26,250 @@ func TestAccResourceJourneySegmentContextOnly(t *testing.T) {
 	runResourceJourneySegmentTestCase(t, "context_only_to_journey_only")
 }
 
+func TestAccResourceJourneySegmentJourneyEventName(t *testing.T) {
+	runResourceJourneySegmentTestCase(t, "journey_event_name")
+}
+
+func TestAccResourceJourneySegmentDefaultOperator(t *testing.T) {
+	runResourceJourneySegmentTestCase(t, "default_operator")
+}
+
+func TestAccResourceJourneySegmentEmptyDescription(t *testing.T) {
+	const testType = "resource"
+	const testSuitName = "journey_segment"
+	const resourceName = "genesyscloud_journey_segment"
+	const idPrefix = "terraform_test_"
+	const testCaseName = "empty_description"
+	setupJourneySegment(t, idPrefix, testCaseName)
+
+	steps := generateTestSteps(testType, testSuitName, testCaseName, resourceName, idPrefix, nil)
+	// Planning description = "" again against the nil description read back from the API is not a change
+	emptyDescriptionPlan := resource.TestStep{
+		Config:   steps[1].Config,
+		PlanOnly: true,
+	}
+	steps = append(steps[:2], append([]resource.TestStep{emptyDescriptionPlan}, steps[2:]...)...)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps:             steps,
+		CheckDestroy:      testVerifyJourneySegmentsDestroyed,
+	})
+}
+
+func TestAccResourceJourneySegmentInactive(t *testing.T) {
+	runResourceJourneySegmentTestCase(t, "inactive")
+}
+
+func TestAccResourceJourneySegmentOutOfBandChange(t *testing.T) {
+	const testType = "resource"
+	const testSuitName = "journey_segment"
+	const resourceName = "genesyscloud_journey_segment"
+	const idPrefix = "terraform_test_"
+	const testCaseName = "out_of_band_change"
+	setupJourneySegment(t, idPrefix, testCaseName)
+
+	steps := generateTestSteps(testType, testSuitName, testCaseName, resourceName, idPrefix, nil)
+	// Bump the segment version outside of terraform so the update has to fetch the latest version
+	steps[1].PreConfig = func() {
+		if err := patchJourneySegmentOutOfBand(idPrefix + testCaseName); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps:             steps,
+		CheckDestroy:      testVerifyJourneySegmentsDestroyed,
+	})
+}
+
+func TestJourneySegmentPatchRetriesStaleVersion(t *testing.T) {
+	var (
+		displayName    = "stale version segment"
+		patchSegment   = &platformclientv2.Patchsegment{DisplayName: &displayName}
+		currentVersion = 1
+		patchedVersion []int
+	)
+	getVersion := func() (*int, *platformclientv2.APIResponse, error) {
+		version := currentVersion
+		if len(patchedVersion) == 0 {
+			// The segment changes after the first read, so the first patch has a stale version
+			currentVersion++
+		}
+		return &version, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	patch := func() (*platformclientv2.APIResponse, error) {
+		patchedVersion = append(patchedVersion, *patchSegment.Version)
+		if *patchSegment.Version != currentVersion {
+			return &platformclientv2.APIResponse{StatusCode: http.StatusConflict}, fmt.Errorf("version %d does not match the current version", *patchSegment.Version)
+		}
+		currentVersion++
+		return &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+
+	if err := patchJourneySegmentWithLatestVersion("segment-id", patchSegment, getVersion, patch); err != nil {
+		t.Fatalf("Expected the update to succeed after retrying, got %v", err)
+	}
+	if len(patchedVersion) != 2 || patchedVersion[0] != 1 || patchedVersion[1] != 2 {
+		t.Errorf("Expected a stale patch with version 1 and a retry with version 2, got %v", patchedVersion)
+	}
+}
+
+func TestAccResourceJourneySegmentAssignmentExpiration(t *testing.T) {
+	const testType = "resource"
+	const testSuitName = "journey_segment"
+	const resourceName = "genesyscloud_journey_segment"
+	const idPrefix = "terraform_test_"
+	const testCaseName = "assignment_expiration"
+	setupJourneySegment(t, idPrefix, testCaseName)
+
+	fullResourceName := resourceName + "." + idPrefix + testCaseName
+	checkFuncs := []resource.TestCheckFunc{
+		resource.TestCheckResourceAttr(fullResourceName, "assignment_expiration_days", "3"),
+		resource.TestCheckNoResourceAttr(fullResourceName, "assignment_expiration_days"),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps:             generateTestSteps(testType, testSuitName, testCaseName, resourceName, idPrefix, checkFuncs),
+		CheckDestroy:      testVerifyJourneySegmentsDestroyed,
+	})
+}
+
+func TestAccResourceJourneySegmentJourneyCriteriaEntityType(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "genesyscloud_journey_segment" "terraform_test_journey_criteria_entity_type" {
+  display_name            = "terraform_test_journey_criteria_entity_type"
+  color                   = "#008000"
+  scope                   = "Session"
+  should_display_to_agent = false
+  journey {
+    patterns {
+      criteria {
+        key                = "page.title"
+        values             = ["Title"]
+        operator           = "notEqual"
+        should_ignore_case = true
+        entity_type        = "visit"
+      }
+      count        = 1
+      stream_type  = "Web"
+      session_type = "web"
+    }
+  }
+}`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`An argument named "entity_type" is not expected here`),
+			},
+		},
+	})
+}
+
+func TestAccResourceJourneySegmentInvalidColor(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "genesyscloud_journey_segment" "terraform_test_invalid_color" {
+  display_name            = "terraform_test_invalid_color"
+  color                   = "blue"
+  scope                   = "Customer"
+  should_display_to_agent = false
+  external_segment {
+    id     = "4654654654"
+    name   = "external segment name"
+    source = "AdobeExperiencePlatform"
+  }
+}`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("must be a hexadecimal color"),
+			},
+		},
+	})
+}
+
+func TestAccResourceJourneySegmentInvalidJourneyCriteriaKey(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "genesyscloud_journey_segment" "terraform_test_invalid_journey_criteria_key" {
+  display_name            = "terraform_test_invalid_journey_criteria_key"
+  color                   = "#008000"
+  scope                   = "Session"
+  should_display_to_agent = false
+  journey {
+    patterns {
+      criteria {
+        key                = "customattributes.plan.values"
+        values             = ["gold"]
+        operator           = "equal"
+        should_ignore_case = true
+      }
+      count        = 1
+      stream_type  = "Web"
+      session_type = "web"
+    }
+  }
+}`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("attributes.<name>.value"),
+			},
+		},
+	})
+}
+
+func TestAccResourceJourneySegmentNoContextOrJourney(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "genesyscloud_journey_segment" "terraform_test_no_context_or_journey" {
+  display_name            = "terraform_test_no_context_or_journey"
+  color                   = "#008000"
+  scope                   = "Session"
+  should_display_to_agent = false
+}`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("must have a context or journey block"),
+			},
+		},
+	})
+}
+
+func TestAccResourceJourneySegmentDescription(t *testing.T) {
+	const testType = "resource"
+	const testSuitName = "journey_segment"
+	const resourceName = "genesyscloud_journey_segment"
+	const idPrefix = "terraform_test_"
+	const testCaseName = "description"
+	setupJourneySegment(t, idPrefix, testCaseName)
+
+	fullResourceName := resourceName + "." + idPrefix + testCaseName
+	checkFuncs := []resource.TestCheckFunc{
+		resource.TestCheckResourceAttr(fullResourceName, "description", "test description of journey segment"),
+		resource.TestCheckResourceAttr(fullResourceName, "description", ""),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps:             generateTestSteps(testType, testSuitName, testCaseName, resourceName, idPrefix, checkFuncs),
+		CheckDestroy:      testVerifyJourneySegmentsDestroyed,
+	})
+}
+
 func runResourceJourneySegmentTestCase(t *testing.T, testCaseName string) {
 	const testType = "resource"
 	const testSuitName = "journey_segment"
@@ -78,6 +324,40 @@ func cleanupJourneySegments(idPrefix string) {
 	}
 }
 
+func patchJourneySegmentOutOfBand(displayName string) error {
+	journeyApi := platformclientv2.NewJourneyApiWithConfig(sdkConfig)
+
+	pageCount := 1 // Needed because of broken journey common paging
+	for pageNum := 1; pageNum <= pageCount; pageNum++ {
+		const pageSize = 100
+		journeySegments, _, getErr := journeyApi.GetJourneySegments("", pageSize, pageNum, true, nil, nil, "")
+		if getErr != nil {
+			return fmt.Errorf("failed to get page of journey segments: %s", getErr)
+		}
+
+		if journeySegments.Entities == nil || len(*journeySegments.Entities) == 0 {
+			break
+		}
+
+		for _, journeySegment := range *journeySegments.Entities {
+			if journeySegment.DisplayName != nil && *journeySegment.DisplayName == displayName {
+				description := "changed out of band"
+				_, _, patchErr := journeyApi.PatchJourneySegment(*journeySegment.Id, platformclientv2.Patchsegment{
+					Version:     journeySegment.Version,
+					Description: &description,
+				})
+				if patchErr != nil {
+					return fmt.Errorf("failed to patch journey segment %s: %s", *journeySegment.Id, patchErr)
+				}
+				return nil
+			}
+		}
+
+		pageCount = *journeySegments.PageCount
+	}
+	return fmt.Errorf("no journey segment found with display name %s", displayName)
+}
+
 func testVerifyJourneySegmentsDestroyed(state *terraform.State) error {
 	journeyApi := platformclientv2.NewJourneyApiWithConfig(sdkConfig)
 	for _, rs := range state.RootModule().Resources {
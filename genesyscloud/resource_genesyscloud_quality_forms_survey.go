@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"sort"
 	"time"
 
 	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/consistency_checker"
@@ -322,7 +323,7 @@ func readSurveyForm(ctx context.Context, d *schema.ResourceData, meta interface{
 			d.Set("published", *surveyForm.Published)
 		}
 		if surveyForm.QuestionGroups != nil {
-			d.Set("question_groups", flattenSurveyQuestionGroups(surveyForm.QuestionGroups))
+			d.Set("question_groups", orderSurveyQuestionGroups(flattenSurveyQuestionGroups(surveyForm.QuestionGroups), d.Get("question_groups").([]interface{})))
 		}
 
 		return cc.CheckState()
@@ -527,6 +528,54 @@ func flattenSurveyQuestionGroups(questionGroups *[]platformclientv2.Surveyquesti
 	return questionGroupList
 }
 
+// The API may return question groups and questions in a different order than they were sent.
+// Keep the order already in state (matched on group name and question text) to avoid spurious diffs.
+func orderSurveyQuestionGroups(questionGroups []interface{}, currentQuestionGroups []interface{}) []interface{} {
+	currentQuestions := make(map[string][]interface{})
+	for _, currentGroup := range currentQuestionGroups {
+		currentGroupMap := currentGroup.(map[string]interface{})
+		name, _ := currentGroupMap["name"].(string)
+		if _, exists := currentQuestions[name]; !exists {
+			currentQuestions[name], _ = currentGroupMap["questions"].([]interface{})
+		}
+	}
+
+	for _, questionGroup := range questionGroups {
+		questionGroupMap := questionGroup.(map[string]interface{})
+		name, _ := questionGroupMap["name"].(string)
+		if questions, ok := questionGroupMap["questions"].([]interface{}); ok {
+			questionGroupMap["questions"] = orderByCurrentIndex(questions, currentQuestions[name], "text")
+		}
+	}
+	return orderByCurrentIndex(questionGroups, currentQuestionGroups, "name")
+}
+
+// Stable sorts items by the position of the item with the same key value in current. Unmatched items are kept last.
+func orderByCurrentIndex(items []interface{}, current []interface{}, key string) []interface{} {
+	indexes := make(map[string]int)
+	for i, currentItem := range current {
+		if value, ok := currentItem.(map[string]interface{})[key].(string); ok {
+			if _, exists := indexes[value]; !exists {
+				indexes[value] = i
+			}
+		}
+	}
+
+	getIndex := func(item interface{}) int {
+		if value, ok := item.(map[string]interface{})[key].(string); ok {
+			if index, exists := indexes[value]; exists {
+				return index
+			}
+		}
+		return len(current)
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		return getIndex(items[i]) < getIndex(items[j])
+	})
+	return items
+}
+
 func flattenSurveyQuestions(questions *[]platformclientv2.Surveyquestion) []interface{} {
 	if questions == nil {
 		return nil
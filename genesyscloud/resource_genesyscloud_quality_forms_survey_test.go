@@ -169,6 +169,73 @@ func TestAccResourceSurveyFormBasic(t *testing.T) {
 	})
 }
 
+func TestAccResourceSurveyFormQuestionGroupOrder(t *testing.T) {
+	formResource1 := "test-survey-form-order"
+
+	yesNoQuestion := func(text string) []surveyFormQuestionStruct {
+		return []surveyFormQuestionStruct{
+			{
+				text:    text,
+				varType: "multipleChoiceQuestion",
+				answerOptions: []answerOptionStruct{
+					{
+						text:  "Yes",
+						value: 1,
+					},
+					{
+						text:  "No",
+						value: 0,
+					},
+				},
+			},
+		}
+	}
+
+	// Groups deliberately not in alphabetical order
+	surveyForm1 := surveyFormStruct{
+		name:     "terraform-form-surveys-" + uuid.NewString(),
+		language: "en-US",
+		questionGroups: []surveyFormQuestionGroupStruct{
+			{
+				name:      "Zulu Question Group",
+				questions: yesNoQuestion("Was the agent polite?"),
+			},
+			{
+				name:      "Alpha Question Group",
+				questions: yesNoQuestion("Was your issue resolved?"),
+			},
+			{
+				name:      "Mike Question Group",
+				questions: yesNoQuestion("Would you contact us again?"),
+			},
+		},
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				// Create. The test framework fails the step if the plan is not empty after apply.
+				Config: generateSurveyFormResource(formResource1, &surveyForm1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("genesyscloud_quality_forms_survey."+formResource1, "question_groups.#", "3"),
+					resource.TestCheckResourceAttr("genesyscloud_quality_forms_survey."+formResource1, "question_groups.0.name", surveyForm1.questionGroups[0].name),
+					resource.TestCheckResourceAttr("genesyscloud_quality_forms_survey."+formResource1, "question_groups.1.name", surveyForm1.questionGroups[1].name),
+					resource.TestCheckResourceAttr("genesyscloud_quality_forms_survey."+formResource1, "question_groups.2.name", surveyForm1.questionGroups[2].name),
+					resource.TestCheckResourceAttr("genesyscloud_quality_forms_survey."+formResource1, "question_groups.0.questions.0.text", surveyForm1.questionGroups[0].questions[0].text),
+				),
+			},
+			{
+				// Re-apply the same config to confirm the order does not drift on refresh
+				Config:   generateSurveyFormResource(formResource1, &surveyForm1),
+				PlanOnly: true,
+			},
+		},
+		CheckDestroy: testVerifySurveyFormDestroyed,
+	})
+}
+
 func TestAccResourceSurveyFormComplete(t *testing.T) {
 	formResource1 := "test-survey-form-1"
 
@@ -7,13 +7,16 @@ import (
 	"log"
 	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/consistency_checker"
 
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -34,10 +37,10 @@ var (
 	queueMediaSettingsResource = &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"alerting_timeout_sec": {
-				Description:  "Alerting timeout in seconds. Must be >= 7",
-				Type:         schema.TypeInt,
-				Required:     true,
-				ValidateFunc: validation.IntAtLeast(7),
+				Description:      "Alerting timeout in seconds. Must be >= 7",
+				Type:             schema.TypeInt,
+				Required:         true,
+				ValidateDiagFunc: validateMediaSettingIntAtLeast(7),
 			},
 			"service_level_percentage": {
 				Description:  "The desired Service Level. A float value between 0 and 1.",
@@ -46,10 +49,10 @@ var (
 				ValidateFunc: validation.FloatBetween(0, 1),
 			},
 			"service_level_duration_ms": {
-				Description:  "Service Level target in milliseconds. Must be >= 1000",
-				Type:         schema.TypeInt,
-				Required:     true,
-				ValidateFunc: validation.IntAtLeast(1000),
+				Description:      "Service Level target in milliseconds. Must be >= 1000",
+				Type:             schema.TypeInt,
+				Required:         true,
+				ValidateDiagFunc: validateMediaSettingIntAtLeast(1000),
 			},
 		},
 	}
@@ -91,7 +94,11 @@ func getAllRoutingQueues(_ context.Context, clientConfig *platformclientv2.Confi
 		}
 
 		for _, queue := range *queues.Entities {
-			resources[*queue.Id] = &ResourceMeta{Name: *queue.Name}
+			resourceMeta := &ResourceMeta{Name: *queue.Name}
+			if queue.Division != nil && queue.Division.Id != nil {
+				resourceMeta.DivisionId = *queue.Division.Id
+			}
+			resources[*queue.Id] = resourceMeta
 		}
 	}
 
@@ -103,10 +110,11 @@ func routingQueueExporter() *ResourceExporter {
 		GetResourcesFunc: getAllWithPooledClient(getAllRoutingQueues),
 		RefAttrs: map[string]*RefAttrSettings{
 			"division_id":                       {RefType: "genesyscloud_auth_division"},
-			"queue_flow_id":                     {RefType: "genesyscloud_flow"},
-			"email_in_queue_flow_id":            {RefType: "genesyscloud_flow"},
-			"message_in_queue_flow_id":          {RefType: "genesyscloud_flow"},
+			"queue_flow_id":                     {RefType: "genesyscloud_flow", DataSourceType: "genesyscloud_flow"},
+			"email_in_queue_flow_id":            {RefType: "genesyscloud_flow", DataSourceType: "genesyscloud_flow"},
+			"message_in_queue_flow_id":          {RefType: "genesyscloud_flow", DataSourceType: "genesyscloud_flow"},
 			"whisper_prompt_id":                 {RefType: "genesyscloud_architect_user_prompt"},
+			"on_hold_prompt_id":                 {RefType: "genesyscloud_architect_user_prompt"},
 			"outbound_messaging_sms_address_id": {}, // Ref type not yet defined
 			"default_script_ids.*":              {}, // Ref type not yet defined
 			"outbound_email_address.route_id":   {RefType: "genesyscloud_routing_email_route"},
@@ -114,12 +122,16 @@ func routingQueueExporter() *ResourceExporter {
 			"bullseye_rings.skills_to_remove":   {RefType: "genesyscloud_routing_skill"},
 			"members.user_id":                   {RefType: "genesyscloud_user"},
 			"wrapup_codes":                      {RefType: "genesyscloud_routing_wrapupcode"},
+			"skill_groups":                      {RefType: "genesyscloud_routing_skill_group"},
+			"groups":                            {RefType: "genesyscloud_group"},
 		},
 		RemoveIfMissing: map[string][]string{
 			"outbound_email_address": {"route_id"},
 			"members":                {"user_id"},
 		},
 		AllowZeroValues: []string{"bullseye_rings.expansion_timeout_seconds"},
+		// Read-only attributes cannot be set in config
+		ExcludedAttributes: []string{"outbound_messaging_sms_address_name", "members_change_summary"},
 	}
 }
 
@@ -134,12 +146,19 @@ func resourceRoutingQueue() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: customdiff.All(
+			customizeQueueFlowTypesDiff,
+			customizeQueueBullseyeSkillsDiff,
+			customizeQueueMembersDiff,
+			customizeQueueDefaultScriptsDiff,
+		),
 		SchemaVersion: 1,
 		Schema: map[string]*schema.Schema{
 			"name": {
-				Description: "Queue name.",
-				Type:        schema.TypeString,
-				Required:    true,
+				Description:      "Queue name. Maximum length of 256 characters.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringLenBetween(1, 256)),
 			},
 			"division_id": {
 				Description: "The division to which this queue will belong. If not set, the home division will be used.",
@@ -148,9 +167,10 @@ func resourceRoutingQueue() *schema.Resource {
 				Computed:    true,
 			},
 			"description": {
-				Description: "Queue description.",
-				Type:        schema.TypeString,
-				Optional:    true,
+				Description:      "Queue description. Maximum length of 512 characters.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringLenBetween(0, 512)),
 			},
 			"media_settings_call": {
 				Description: "Call media settings.",
@@ -223,9 +243,10 @@ func resourceRoutingQueue() *schema.Resource {
 							ValidateFunc: validation.StringInSlice([]string{"MEETS_THRESHOLD", "ANY"}, false),
 						},
 						"threshold": {
-							Description: "Threshold required for routing attempt (generally an agent score). Ignored for operator ANY.",
-							Type:        schema.TypeInt,
-							Optional:    true,
+							Description:      "Threshold required for routing attempt (generally an agent score). Ignored for operator ANY.",
+							Type:             schema.TypeInt,
+							Optional:         true,
+							DiffSuppressFunc: suppressRoutingRuleThresholdForAny,
 						},
 						"wait_seconds": {
 							Description:  "Seconds to wait in this rule before moving to the next.",
@@ -300,6 +321,11 @@ func resourceRoutingQueue() *schema.Resource {
 				Type:        schema.TypeString,
 				Optional:    true,
 			},
+			"on_hold_prompt_id": {
+				Description: "The audio to be played when calls on this queue are on hold. If not configured, the default on-hold music will play.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
 			"auto_answer_only": {
 				Description: "Specifies whether the configured whisper should play for all ACD calls, or only for those which are auto-answered.",
 				Type:        schema.TypeBool,
@@ -324,22 +350,30 @@ func resourceRoutingQueue() *schema.Resource {
 				Optional:    true,
 			},
 			"calling_party_number": {
-				Description: "The phone number to use for caller identification for outbound calls from this queue.",
-				Type:        schema.TypeString,
-				Optional:    true,
+				Description:      "The phone number to use for caller identification for outbound calls from this queue. Must be in E.164 format, e.g. `+13175551234`, or a short code of digits only. The leading `+` is optional.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validateE164PhoneNumber,
 			},
 			"default_script_ids": {
-				Description:      "The default script IDs for each communication type. Communication types: (CALL | CALLBACK | CHAT | COBROWSE | EMAIL | MESSAGE | SOCIAL_EXPRESSION | VIDEO | SCREENSHARE)",
+				Description:      "The default script IDs for each communication type. Communication types: (CALL | CALLBACK | CHAT | COBROWSE | EMAIL | MESSAGE | SOCIAL_EXPRESSION | VIDEO | SCREENSHARE). If not set, this resource will not manage default scripts. Set to an empty map to remove all default scripts.",
 				Type:             schema.TypeMap,
 				ValidateDiagFunc: validateMapCommTypes,
 				Optional:         true,
+				Computed:         true,
 				Elem:             &schema.Schema{Type: schema.TypeString},
+				DiffSuppressFunc: suppressDefaultScriptIdsKeyCase,
 			},
 			"outbound_messaging_sms_address_id": {
 				Description: "The unique ID of the outbound messaging SMS address for the queue.",
 				Type:        schema.TypeString,
 				Optional:    true,
 			},
+			"outbound_messaging_sms_address_name": {
+				Description: "The name of the outbound messaging SMS address for the queue, if available.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
 			"outbound_email_address": {
 				Description: "The outbound email address settings for this queue.",
 				Type:        schema.TypeList,
@@ -367,6 +401,12 @@ func resourceRoutingQueue() *schema.Resource {
 				Computed:    true,
 				ConfigMode:  schema.SchemaConfigModeAttr,
 				Elem:        queueMemberResource,
+				Set:         queueMemberHash,
+			},
+			"members_change_summary": {
+				Description: "Summary of the most recent planned change to `members`, e.g. `2 to add, 1 to remove, 10 after apply`. Shown in the plan and kept in state until `members` changes again.",
+				Type:        schema.TypeString,
+				Computed:    true,
 			},
 			"wrapup_codes": {
 				Description: "IDs of wrapup codes assigned to this queue. If not set, this resource will not manage wrapup codes.",
@@ -375,6 +415,20 @@ func resourceRoutingQueue() *schema.Resource {
 				Computed:    true,
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
+			"skill_groups": {
+				Description: "IDs of skill groups whose members are added to this queue. If not set, this resource will not manage skill groups.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"groups": {
+				Description: "IDs of groups whose members are added to this queue. If not set, this resource will not manage groups.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
 		},
 	}
 }
@@ -390,6 +444,10 @@ func createQueue(ctx context.Context, d *schema.ResourceData, meta interface{})
 	callingPartyName := d.Get("calling_party_name").(string)
 	callingPartyNumber := d.Get("calling_party_number").(string)
 
+	if fallbackErr := checkHomeDivisionFallback(d, meta); fallbackErr != nil {
+		return fallbackErr
+	}
+
 	sdkConfig := meta.(*providerMeta).ClientConfig
 	routingAPI := platformclientv2.NewRoutingApiWithConfig(sdkConfig)
 
@@ -405,6 +463,7 @@ func createQueue(ctx context.Context, d *schema.ResourceData, meta interface{})
 		EmailInQueueFlow:           buildSdkDomainEntityRef(d, "email_in_queue_flow_id"),
 		MessageInQueueFlow:         buildSdkDomainEntityRef(d, "message_in_queue_flow_id"),
 		WhisperPrompt:              buildSdkDomainEntityRef(d, "whisper_prompt_id"),
+		OnHoldPrompt:               buildSdkDomainEntityRef(d, "on_hold_prompt_id"),
 		AutoAnswerOnly:             &autoAnswerOnly,
 		CallingPartyName:           &callingPartyName,
 		CallingPartyNumber:         &callingPartyNumber,
@@ -413,6 +472,7 @@ func createQueue(ctx context.Context, d *schema.ResourceData, meta interface{})
 		OutboundEmailAddress:       buildSdkQueueEmailAddress(d),
 		EnableTranscription:        &enableTranscription,
 		EnableManualAssignment:     &enableManualAssignment,
+		MemberGroups:               buildSdkQueueMemberGroups(d),
 	}
 
 	if divisionID != "" {
@@ -453,7 +513,8 @@ func readQueue(ctx context.Context, d *schema.ResourceData, meta interface{}) di
 			return resource.NonRetryableError(fmt.Errorf("Failed to read queue %s: %s", d.Id(), getErr))
 		}
 
-		cc := consistency_checker.NewConsistencyCheck(ctx, d, meta, resourceRoutingQueue())
+		// acw_timeout_ms may be defaulted by the server after a write
+		cc := consistency_checker.NewConsistencyCheck(ctx, d, meta, resourceRoutingQueue(), "acw_timeout_ms")
 		if currentQueue.Name != nil {
 			d.Set("name", *currentQueue.Name)
 		} else {
@@ -556,6 +617,12 @@ func readQueue(ctx context.Context, d *schema.ResourceData, meta interface{}) di
 			d.Set("whisper_prompt_id", nil)
 		}
 
+		if currentQueue.OnHoldPrompt != nil && currentQueue.OnHoldPrompt.Id != nil {
+			d.Set("on_hold_prompt_id", *currentQueue.OnHoldPrompt.Id)
+		} else {
+			d.Set("on_hold_prompt_id", nil)
+		}
+
 		if currentQueue.AutoAnswerOnly != nil {
 			d.Set("auto_answer_only", *currentQueue.AutoAnswerOnly)
 		} else {
@@ -594,8 +661,10 @@ func readQueue(ctx context.Context, d *schema.ResourceData, meta interface{}) di
 
 		if currentQueue.OutboundMessagingAddresses != nil && currentQueue.OutboundMessagingAddresses.SmsAddress != nil {
 			d.Set("outbound_messaging_sms_address_id", *currentQueue.OutboundMessagingAddresses.SmsAddress.Id)
+			setNillableValue(d, "outbound_messaging_sms_address_name", currentQueue.OutboundMessagingAddresses.SmsAddress.Name)
 		} else {
 			d.Set("outbound_messaging_sms_address_id", nil)
+			d.Set("outbound_messaging_sms_address_name", nil)
 		}
 
 		if currentQueue.OutboundEmailAddress != nil && *currentQueue.OutboundEmailAddress != nil {
@@ -605,6 +674,9 @@ func readQueue(ctx context.Context, d *schema.ResourceData, meta interface{}) di
 			d.Set("outbound_email_address", nil)
 		}
 
+		d.Set("skill_groups", flattenQueueMemberGroups(currentQueue.MemberGroups, queueMemberGroupTypeSkillGroup))
+		d.Set("groups", flattenQueueMemberGroups(currentQueue.MemberGroups, queueMemberGroupTypeGroup))
+
 		members, err := flattenQueueMembers(d.Id(), routingAPI)
 		if err != nil {
 			return resource.NonRetryableError(fmt.Errorf("%v", err))
@@ -632,12 +704,16 @@ func updateQueue(ctx context.Context, d *schema.ResourceData, meta interface{})
 	callingPartyName := d.Get("calling_party_name").(string)
 	callingPartyNumber := d.Get("calling_party_number").(string)
 
+	if fallbackErr := checkHomeDivisionFallback(d, meta); fallbackErr != nil {
+		return fallbackErr
+	}
+
 	sdkConfig := meta.(*providerMeta).ClientConfig
 	routingAPI := platformclientv2.NewRoutingApiWithConfig(sdkConfig)
 
 	log.Printf("Updating queue %s", name)
 
-	_, _, err := routingAPI.PutRoutingQueue(d.Id(), platformclientv2.Queuerequest{
+	queueRequest := platformclientv2.Queuerequest{
 		Name:                       &name,
 		Description:                &description,
 		MediaSettings:              buildSdkMediaSettings(d),
@@ -649,6 +725,7 @@ func updateQueue(ctx context.Context, d *schema.ResourceData, meta interface{})
 		EmailInQueueFlow:           buildSdkDomainEntityRef(d, "email_in_queue_flow_id"),
 		MessageInQueueFlow:         buildSdkDomainEntityRef(d, "message_in_queue_flow_id"),
 		WhisperPrompt:              buildSdkDomainEntityRef(d, "whisper_prompt_id"),
+		OnHoldPrompt:               buildSdkDomainEntityRef(d, "on_hold_prompt_id"),
 		AutoAnswerOnly:             &autoAnswerOnly,
 		CallingPartyName:           &callingPartyName,
 		CallingPartyNumber:         &callingPartyNumber,
@@ -657,12 +734,14 @@ func updateQueue(ctx context.Context, d *schema.ResourceData, meta interface{})
 		OutboundEmailAddress:       buildSdkQueueEmailAddress(d),
 		EnableTranscription:        &enableTranscription,
 		EnableManualAssignment:     &enableManualAssignment,
-	})
+		MemberGroups:               buildSdkQueueMemberGroups(d),
+	}
+	_, _, err := routingAPI.PutRoutingQueue(d.Id(), queueRequest)
 	if err != nil {
 		return diag.Errorf("Error updating queue %s: %s", name, err)
 	}
 
-	diagErr := updateObjectDivision(d, "QUEUE", sdkConfig)
+	diagErr := updateObjectDivision(d, "QUEUE", meta)
 	if diagErr != nil {
 		return diagErr
 	}
@@ -772,8 +851,16 @@ func buildSdkMediaSetting(settings []interface{}) platformclientv2.Mediasetting
 func flattenMediaSetting(settings platformclientv2.Mediasetting) []interface{} {
 	settingsMap := make(map[string]interface{})
 	settingsMap["alerting_timeout_sec"] = *settings.AlertingTimeoutSeconds
-	settingsMap["service_level_percentage"] = *settings.ServiceLevel.Percentage
-	settingsMap["service_level_duration_ms"] = *settings.ServiceLevel.DurationMs
+	if settings.ServiceLevel != nil {
+		// A zero percentage may be omitted by the API. Keep it as 0 so a configured 0 does not show a diff.
+		settingsMap["service_level_percentage"] = 0.0
+		if settings.ServiceLevel.Percentage != nil {
+			settingsMap["service_level_percentage"] = *settings.ServiceLevel.Percentage
+		}
+		if settings.ServiceLevel.DurationMs != nil {
+			settingsMap["service_level_duration_ms"] = *settings.ServiceLevel.DurationMs
+		}
+	}
 	return []interface{}{settingsMap}
 }
 
@@ -786,7 +873,8 @@ func buildSdkRoutingRules(d *schema.ResourceData) *[]platformclientv2.Routingrul
 			if operator, ok := ruleSettings["operator"].(string); ok {
 				sdkRule.Operator = &operator
 			}
-			if threshold, ok := ruleSettings["threshold"]; ok {
+			// Threshold is ignored for the ANY operator so don't send it
+			if threshold, ok := ruleSettings["threshold"]; ok && sdkRule.Operator != nil && *sdkRule.Operator != "ANY" {
 				v := threshold.(int)
 				sdkRule.Threshold = &v
 			}
@@ -806,7 +894,7 @@ func flattenRoutingRules(sdkRoutingRules []platformclientv2.Routingrule) []inter
 		if sdkRule.Operator != nil {
 			ruleSettings["operator"] = *sdkRule.Operator
 		}
-		if sdkRule.Threshold != nil {
+		if sdkRule.Threshold != nil && (sdkRule.Operator == nil || *sdkRule.Operator != "ANY") {
 			ruleSettings["threshold"] = *sdkRule.Threshold
 		}
 		if sdkRule.WaitSeconds != nil {
@@ -817,6 +905,12 @@ func flattenRoutingRules(sdkRoutingRules []platformclientv2.Routingrule) []inter
 	return rules
 }
 
+func suppressRoutingRuleThresholdForAny(k, _, _ string, d *schema.ResourceData) bool {
+	// k is of the form routing_rules.N.threshold
+	operatorKey := strings.TrimSuffix(k, "threshold") + "operator"
+	return d.Get(operatorKey).(string) == "ANY"
+}
+
 func buildSdkBullseyeSettings(d *schema.ResourceData) *platformclientv2.Bullseye {
 	if configRings, ok := d.GetOk("bullseye_rings"); ok {
 		var sdkRings []platformclientv2.Ring
@@ -859,7 +953,9 @@ func flattenBullseyeRings(sdkRings []platformclientv2.Ring) []interface{} {
 		ringSettings := make(map[string]interface{})
 		if sdkRing.ExpansionCriteria != nil {
 			for _, criteria := range *sdkRing.ExpansionCriteria {
-				if *criteria.VarType == bullseyeExpansionTypeTimeout {
+				// TIMEOUT_SECONDS is the only expansion criteria type defined by the API.
+				// Skill based expansion is configured through the ring's skills_to_remove action.
+				if criteria.VarType != nil && *criteria.VarType == bullseyeExpansionTypeTimeout && criteria.Threshold != nil {
 					ringSettings["expansion_timeout_seconds"] = *criteria.Threshold
 					break
 				}
@@ -896,17 +992,75 @@ func buildSdkAcwSettings(d *schema.ResourceData) *platformclientv2.Acwsettings {
 }
 
 func buildSdkDefaultScriptsMap(d *schema.ResourceData) *map[string]platformclientv2.Script {
-	if scriptIds, ok := d.GetOk("default_script_ids"); ok {
-		scriptMap := scriptIds.(map[string]interface{})
+	scriptIds, ok := d.GetOk("default_script_ids")
+	if !ok {
+		if d.HasChange("default_script_ids") {
+			// Scripts were removed from the config. An empty map clears them on the queue.
+			return &map[string]platformclientv2.Script{}
+		}
+		// An omitted defaultScripts property leaves existing scripts on the queue
+		return nil
+	}
+
+	results := make(map[string]platformclientv2.Script)
+	for k, v := range scriptIds.(map[string]interface{}) {
+		scriptID := v.(string)
+		results[strings.ToUpper(k)] = platformclientv2.Script{Id: &scriptID}
+	}
+	return &results
+}
+
+const (
+	queueMemberGroupTypeSkillGroup = "SKILLGROUP"
+	queueMemberGroupTypeGroup      = "GROUP"
+)
+
+func buildSdkQueueMemberGroups(d *schema.ResourceData) *[]platformclientv2.Membergroup {
+	// Omit memberGroups unless this resource manages them, as an empty list removes
+	// groups that were added to the queue outside of Terraform
+	if !isQueueAttrConfigured(d, "skill_groups") && !isQueueAttrConfigured(d, "groups") && !d.HasChanges("skill_groups", "groups") {
+		return nil
+	}
+	memberGroups := make([]platformclientv2.Membergroup, 0)
+	memberGroups = append(memberGroups, buildSdkQueueMemberGroupList(d, "skill_groups", queueMemberGroupTypeSkillGroup)...)
+	memberGroups = append(memberGroups, buildSdkQueueMemberGroupList(d, "groups", queueMemberGroupTypeGroup)...)
+	return &memberGroups
+}
 
-		results := make(map[string]platformclientv2.Script)
-		for k, v := range scriptMap {
-			scriptID := v.(string)
-			results[k] = platformclientv2.Script{Id: &scriptID}
+func buildSdkQueueMemberGroupList(d *schema.ResourceData, key string, groupType string) []platformclientv2.Membergroup {
+	var memberGroups []platformclientv2.Membergroup
+	if groupIds, ok := d.GetOk(key); ok {
+		for _, groupId := range *setToStringList(groupIds.(*schema.Set)) {
+			id := groupId
+			memberGroups = append(memberGroups, platformclientv2.Membergroup{Id: &id, VarType: &groupType})
 		}
-		return &results
 	}
-	return nil
+	return memberGroups
+}
+
+func isQueueAttrConfigured(d *schema.ResourceData, key string) bool {
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		return false
+	}
+	return !rawConfig.GetAttr(key).IsNull()
+}
+
+func flattenQueueMemberGroups(memberGroups *[]platformclientv2.Membergroup, groupType string) *schema.Set {
+	if memberGroups == nil {
+		return nil
+	}
+
+	var groupIds []string
+	for _, memberGroup := range *memberGroups {
+		if memberGroup.Id != nil && memberGroup.VarType != nil && *memberGroup.VarType == groupType {
+			groupIds = append(groupIds, *memberGroup.Id)
+		}
+	}
+	if groupIds == nil {
+		return nil
+	}
+	return stringListToSet(groupIds)
 }
 
 func flattenDefaultScripts(sdkScripts map[string]platformclientv2.Script) map[string]interface{} {
@@ -916,11 +1070,79 @@ func flattenDefaultScripts(sdkScripts map[string]platformclientv2.Script) map[st
 
 	results := make(map[string]interface{})
 	for k, v := range sdkScripts {
-		results[k] = *v.Id
+		if v.Id == nil {
+			continue
+		}
+		results[strings.ToUpper(k)] = *v.Id
+	}
+	if len(results) == 0 {
+		return nil
 	}
 	return results
 }
 
+// Communication type keys are case-insensitive and always read back in uppercase, so a key that only differs in case is not a change
+func suppressDefaultScriptIdsKeyCase(k, old, new string, d *schema.ResourceData) bool {
+	key := strings.TrimPrefix(k, "default_script_ids.")
+	if key == "%" {
+		return false
+	}
+
+	oldScripts, newScripts := d.GetChange("default_script_ids")
+	if old == "" {
+		// Key only in config. Suppress if state has the same script under a differently cased key
+		return hasScriptWithKeyFold(oldScripts.(map[string]interface{}), key, new)
+	}
+	if new == "" {
+		// Key only in state. Suppress if config has the same script under a differently cased key
+		return hasScriptWithKeyFold(newScripts.(map[string]interface{}), key, old)
+	}
+	return false
+}
+
+func hasScriptWithKeyFold(scripts map[string]interface{}, key string, scriptID string) bool {
+	for k, v := range scripts {
+		if k != key && strings.EqualFold(k, key) && v == scriptID {
+			return true
+		}
+	}
+	return false
+}
+
+// Media settings blocks share one schema, so name the failing block in the error, e.g. media_settings_email.0.service_level_duration_ms
+func validateMediaSettingIntAtLeast(min int) schema.SchemaValidateDiagFunc {
+	return func(val interface{}, path cty.Path) diag.Diagnostics {
+		if v, ok := val.(int); ok && v >= min {
+			return nil
+		}
+		return diag.Diagnostics{
+			{
+				Severity:      diag.Error,
+				Summary:       fmt.Sprintf("%s must be >= %d", formatAttributePath(path), min),
+				AttributePath: path,
+			},
+		}
+	}
+}
+
+func formatAttributePath(path cty.Path) string {
+	parts := make([]string, 0, len(path))
+	for _, step := range path {
+		switch s := step.(type) {
+		case cty.GetAttrStep:
+			parts = append(parts, s.Name)
+		case cty.IndexStep:
+			if s.Key.Type() == cty.Number {
+				index, _ := s.Key.AsBigFloat().Int64()
+				parts = append(parts, strconv.FormatInt(index, 10))
+			} else if s.Key.Type() == cty.String {
+				parts = append(parts, s.Key.AsString())
+			}
+		}
+	}
+	return strings.Join(parts, ".")
+}
+
 func validateMapCommTypes(val interface{}, _ cty.Path) diag.Diagnostics {
 	if val == nil {
 		return nil
@@ -929,7 +1151,7 @@ func validateMapCommTypes(val interface{}, _ cty.Path) diag.Diagnostics {
 	commTypes := []string{"CALL", "CALLBACK", "CHAT", "COBROWSE", "EMAIL", "MESSAGE", "SOCIAL_EXPRESSION", "VIDEO", "SCREENSHARE"}
 	m := val.(map[string]interface{})
 	for k := range m {
-		if !stringInSlice(k, commTypes) {
+		if !stringInSlice(strings.ToUpper(k), commTypes) {
 			return diag.Errorf("%s is an invalid communication type key.", k)
 		}
 	}
@@ -1102,23 +1324,21 @@ func updateQueueMembers(d *schema.ResourceData, routingAPI *platformclientv2.Rou
 			}
 
 			// Check for ring numbers to update
+			ringNumUpdates := make(map[string]int)
 			for userID, newNum := range newUserRingNums {
 				if oldNum, found := oldUserRingNums[userID]; found {
 					if newNum != oldNum {
 						// Number changed. Update ring number
-						err := updateQueueUserRingNum(d.Id(), userID, newNum, routingAPI)
-						if err != nil {
-							return err
-						}
+						ringNumUpdates[userID] = newNum
 					}
 				} else if newNum != 1 {
 					// New queue member. Update ring num if not set to the default of 1
-					err := updateQueueUserRingNum(d.Id(), userID, newNum, routingAPI)
-					if err != nil {
-						return err
-					}
+					ringNumUpdates[userID] = newNum
 				}
 			}
+			if err := updateQueueUserRingNums(d.Id(), ringNumUpdates, routingAPI); err != nil {
+				return err
+			}
 			log.Printf("Members updated for Queue %s", d.Get("name"))
 		}
 	}
@@ -1148,6 +1368,46 @@ func updateMembersInChunks(queueID string, membersToUpdate []string, remove bool
 	return nil
 }
 
+// The API has no bulk ring number update, so each member is patched individually with bounded concurrency.
+// Every patch is attempted and all failures are returned together.
+func updateQueueUserRingNums(queueID string, ringNums map[string]int, api *platformclientv2.RoutingApi) diag.Diagnostics {
+	if len(ringNums) == 0 {
+		return nil
+	}
+
+	type ringNumUpdate struct {
+		userID  string
+		ringNum int
+	}
+	updates := make(chan ringNumUpdate)
+
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		allErrs diag.Diagnostics
+	)
+	for i := 0; i < getWorkerCount(len(ringNums)); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for update := range updates {
+				if err := updateQueueUserRingNum(queueID, update.userID, update.ringNum, api); err != nil {
+					mu.Lock()
+					allErrs = append(allErrs, err...)
+					mu.Unlock()
+				}
+			}
+		}()
+	}
+	for userID, ringNum := range ringNums {
+		updates <- ringNumUpdate{userID: userID, ringNum: ringNum}
+	}
+	close(updates)
+	wg.Wait()
+
+	return allErrs
+}
+
 func updateQueueUserRingNum(queueID string, userID string, ringNum int, api *platformclientv2.RoutingApi) diag.Diagnostics {
 	_, err := api.PatchRoutingQueueMember(queueID, userID, platformclientv2.Queuemember{
 		Id:         &userID,
@@ -1159,25 +1419,34 @@ func updateQueueUserRingNum(queueID string, userID string, ringNum int, api *pla
 	return nil
 }
 
-func getRoutingQueueMembers(queueID string, api *platformclientv2.RoutingApi) ([]platformclientv2.Queuemember, diag.Diagnostics) {
-	const maxPageSize = 100
+// Page size for reading queue members. The members endpoint rejects page sizes above 100
+const queueMembersPageSize = 100
 
+// Expand values are added to each member, e.g. routingStatus. The resource reads members without expansion
+func getRoutingQueueMembers(queueID string, api *platformclientv2.RoutingApi, expand ...string) ([]platformclientv2.Queuemember, diag.Diagnostics) {
 	var members []platformclientv2.Queuemember
 	for pageNum := 1; ; pageNum++ {
-		users, _, err := sdkGetRoutingQueueMembers(queueID, pageNum, maxPageSize, api)
+		users, resp, err := sdkGetRoutingQueueMembers(queueID, pageNum, queueMembersPageSize, expand, api)
 		if err != nil {
 			return nil, diag.Errorf("Failed to query users for queue %s: %s", queueID, err)
 		}
+		if resp == nil || resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+			// Never treat a failed page as the end of the list, or a partial member set would be read
+			return nil, diag.Errorf("Failed to query users for queue %s: unexpected response for page %d", queueID, pageNum)
+		}
 		if users == nil || users.Entities == nil || len(*users.Entities) == 0 {
 			return members, nil
 		}
 		for _, user := range *users.Entities {
 			members = append(members, user)
 		}
+		if users.PageCount != nil && pageNum >= *users.PageCount {
+			return members, nil
+		}
 	}
 }
 
-func sdkGetRoutingQueueMembers(queueID string, pageNumber int, pageSize int, api *platformclientv2.RoutingApi) (*platformclientv2.Queuememberentitylisting, *platformclientv2.APIResponse, error) {
+func sdkGetRoutingQueueMembers(queueID string, pageNumber int, pageSize int, expand []string, api *platformclientv2.RoutingApi) (*platformclientv2.Queuememberentitylisting, *platformclientv2.APIResponse, error) {
 	// SDK does not support nil values for boolean query params yet, so we must manually construct this HTTP request for now
 	apiClient := &api.Configuration.APIClient
 
@@ -1203,6 +1472,11 @@ func sdkGetRoutingQueueMembers(queueID string, pageNumber int, pageSize int, api
 
 	queryParams["pageSize"] = apiClient.ParameterToString(pageSize, "")
 	queryParams["pageNumber"] = apiClient.ParameterToString(pageNumber, "")
+	// Only direct user members. Users added through skill groups or groups are managed by those attributes
+	queryParams["memberBy"] = "user"
+	if len(expand) > 0 {
+		queryParams["expand"] = strings.Join(expand, ",")
+	}
 
 	headerParams["Content-Type"] = "application/json"
 	headerParams["Accept"] = "application/json"
@@ -1225,7 +1499,7 @@ func flattenQueueMembers(queueID string, api *platformclientv2.RoutingApi) (*sch
 		return nil, err
 	}
 
-	memberSet := schema.NewSet(schema.HashResource(queueMemberResource), []interface{}{})
+	memberSet := schema.NewSet(queueMemberHash, []interface{}{})
 	for _, member := range members {
 		memberMap := make(map[string]interface{})
 		memberMap["user_id"] = *member.Id
@@ -1257,3 +1531,139 @@ func flattenQueueWrapupCodes(queueID string, api *platformclientv2.RoutingApi) (
 	}
 	return nil, nil
 }
+
+// In-queue flow attributes and the flow type each one must reference
+var queueFlowTypes = map[string]string{
+	"queue_flow_id":            "INQUEUECALL",
+	"email_in_queue_flow_id":   "INQUEUEEMAIL",
+	"message_in_queue_flow_id": "INQUEUESHORTMESSAGE",
+}
+
+func customizeQueueFlowTypesDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !meta.(*providerMeta).ValidateQueueFlowTypes {
+		return nil
+	}
+
+	sdkConfig := meta.(*providerMeta).ClientConfig
+	architectAPI := platformclientv2.NewArchitectApiWithConfig(sdkConfig)
+
+	for attr, flowType := range queueFlowTypes {
+		if !diff.HasChange(attr) || !diff.NewValueKnown(attr) {
+			// Flow ID unchanged or not yet known. Nothing to validate.
+			continue
+		}
+		flowId := diff.Get(attr).(string)
+		if flowId == "" {
+			continue
+		}
+
+		flow, _, err := architectAPI.GetFlow(flowId, false)
+		if err != nil {
+			return fmt.Errorf("Failed to read flow %s for %s: %s", flowId, attr, err)
+		}
+		if flow.VarType == nil || !strings.EqualFold(*flow.VarType, flowType) {
+			actualType := "unknown"
+			if flow.VarType != nil {
+				actualType = *flow.VarType
+			}
+			return fmt.Errorf("%s references flow %s of type %s. Only %s flows are supported.", attr, flowId, actualType, flowType)
+		}
+	}
+	return nil
+}
+
+func customizeQueueBullseyeSkillsDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !meta.(*providerMeta).ValidateQueueBullseyeSkills || !diff.HasChange("bullseye_rings") {
+		return nil
+	}
+
+	sdkConfig := meta.(*providerMeta).ClientConfig
+	routingAPI := platformclientv2.NewRoutingApiWithConfig(sdkConfig)
+
+	rings, _ := diff.Get("bullseye_rings").([]interface{})
+	for i, ring := range rings {
+		skillsKey := fmt.Sprintf("bullseye_rings.%d.skills_to_remove", i)
+		if !diff.NewValueKnown(skillsKey) {
+			// Skill IDs not yet known. Nothing to validate.
+			continue
+		}
+		ringSettings, ok := ring.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		skills, ok := ringSettings["skills_to_remove"].(*schema.Set)
+		if !ok {
+			continue
+		}
+		for _, id := range skills.List() {
+			skillId := id.(string)
+			if skillId == "" {
+				continue
+			}
+			_, resp, err := routingAPI.GetRoutingSkill(skillId)
+			if err != nil {
+				if isStatus404(resp) {
+					return fmt.Errorf("bullseye_rings.%d.skills_to_remove references skill %s which does not exist", i, skillId)
+				}
+				return fmt.Errorf("Failed to read skill %s for bullseye_rings.%d.skills_to_remove: %s", skillId, i, err)
+			}
+		}
+	}
+	return nil
+}
+
+func customizeQueueMembersDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	// Report how many members will be added and removed so large membership changes can be checked during plan
+	if diff.Id() == "" || !diff.HasChange("members") || !diff.NewValueKnown("members") {
+		return nil
+	}
+
+	oldMembers, newMembers := diff.GetChange("members")
+	oldUserIds := getQueueMemberUserIds(oldMembers.(*schema.Set))
+	newUserIds := getQueueMemberUserIds(newMembers.(*schema.Set))
+
+	summary := fmt.Sprintf("%d to add, %d to remove, %d after apply",
+		len(sliceDifference(newUserIds, oldUserIds)),
+		len(sliceDifference(oldUserIds, newUserIds)),
+		len(newUserIds))
+	log.Printf("Queue %s membership changes: %s", diff.Get("name").(string), summary)
+	return diff.SetNew("members_change_summary", summary)
+}
+
+// Members are keyed on user_id only so a ring_num change is planned as an in-place update of that member
+func queueMemberHash(val interface{}) int {
+	userId, _ := val.(map[string]interface{})["user_id"].(string)
+	return schema.HashString(userId)
+}
+
+func getQueueMemberUserIds(members *schema.Set) []string {
+	memberList := members.List()
+	userIds := make([]string, 0, len(memberList))
+	for _, member := range memberList {
+		if userId, ok := member.(map[string]interface{})["user_id"].(string); ok && userId != "" {
+			userIds = append(userIds, userId)
+		}
+	}
+	return userIds
+}
+
+func customizeQueueDefaultScriptsDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	// default_script_ids is computed so scripts set outside of Terraform are kept when it is not configured.
+	// An explicitly empty map still removes all scripts from the queue.
+	if diff.Id() == "" {
+		return nil
+	}
+	rawConfig := diff.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		return nil
+	}
+	rawScripts := rawConfig.GetAttr("default_script_ids")
+	if rawScripts.IsNull() || !rawScripts.IsKnown() || rawScripts.LengthInt() > 0 {
+		return nil
+	}
+	oldScripts, _ := diff.GetChange("default_script_ids")
+	if scripts, ok := oldScripts.(map[string]interface{}); !ok || len(scripts) == 0 {
+		return nil
+	}
+	return diff.SetNew("default_script_ids", map[string]interface{}{})
+}
@@ -1,7 +1,10 @@
 package genesyscloud
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"regexp"
 	"strconv"
 	"strings"
 	"testing"
@@ -133,6 +136,28 @@ func TestAccResourceRoutingQueueBasic(t *testing.T) {
 					validateRoutingRules(queueResource1, 1, routingRuleOpAny, "45", "15"),
 				),
 			},
+			{
+				// Remove all routing rules
+				Config: generateRoutingQueueResourceBasic(
+					queueResource1,
+					queueName2,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("genesyscloud_routing_queue."+queueResource1, "name", queueName2),
+					resource.TestCheckResourceAttr("genesyscloud_routing_queue."+queueResource1, "routing_rules.#", "0"),
+				),
+			},
+			{
+				// A service level of 0 means no target and must not be treated as unset
+				Config: generateRoutingQueueResourceBasic(
+					queueResource1,
+					queueName2,
+					generateMediaSettings("media_settings_call", alertTimeout2, "0", slDuration2),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					validateMediaSettings(queueResource1, "media_settings_call", alertTimeout2, "0", slDuration2),
+				),
+			},
 			{
 				// Import/Read
 				ResourceName:      "genesyscloud_routing_queue." + queueResource1,
@@ -281,6 +306,193 @@ func TestAccResourceRoutingQueueFlows(t *testing.T) {
 	})
 }
 
+func TestAccResourceRoutingQueueOnHoldPrompt(t *testing.T) {
+	var (
+		queueResource     = "test-queue-on-hold"
+		queueName         = "Terraform Test Queue-" + uuid.NewString()
+		fullQueueResource = "genesyscloud_routing_queue." + queueResource
+		promptResource    = "test-on-hold-prompt"
+		promptName        = "TerraformOnHold" + strings.Replace(uuid.NewString(), "-", "", -1)
+		promptAsset       = userPromptResourceStruct{
+			"en-us",
+			strconv.Quote("Please continue to hold"),
+			nullValue,
+			nullValue,
+		}
+		prompt = generateUserPromptResource(&userPromptStruct{
+			promptResource,
+			promptName,
+			strconv.Quote("On hold prompt for queue test"),
+			[]*userPromptResourceStruct{&promptAsset},
+		})
+	)
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: prompt + generateRoutingQueueResourceBasic(
+					queueResource,
+					queueName,
+					"on_hold_prompt_id = genesyscloud_architect_user_prompt."+promptResource+".id",
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(fullQueueResource, "on_hold_prompt_id", "genesyscloud_architect_user_prompt."+promptResource, "id"),
+				),
+			},
+			{
+				// Remove the on-hold prompt
+				Config: prompt + generateRoutingQueueResourceBasic(
+					queueResource,
+					queueName,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr(fullQueueResource, "on_hold_prompt_id"),
+				),
+			},
+			{
+				// Import/Read
+				ResourceName:      fullQueueResource,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+		CheckDestroy: testVerifyQueuesDestroyed,
+	})
+}
+
+func TestQueueMemberRingNumPlannedInPlace(t *testing.T) {
+	var (
+		queueID = uuid.NewString()
+		userID  = uuid.NewString()
+	)
+	ringNumKey := fmt.Sprintf("members.%d.ring_num", queueMemberHash(map[string]interface{}{"user_id": userID}))
+	userIDKey := fmt.Sprintf("members.%d.user_id", queueMemberHash(map[string]interface{}{"user_id": userID}))
+
+	state := &terraform.InstanceState{
+		ID: queueID,
+		Attributes: map[string]string{
+			"id":        queueID,
+			"name":      "Terraform Test Queue",
+			"members.#": "1",
+			userIDKey:   userID,
+			ringNumKey:  "1",
+		},
+	}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name": "Terraform Test Queue",
+		"members": []interface{}{
+			map[string]interface{}{"user_id": userID, "ring_num": 3},
+		},
+	})
+
+	planDiff, err := resourceRoutingQueue().Diff(context.Background(), state, config, &providerMeta{})
+	if err != nil {
+		t.Fatalf("Failed to plan queue: %v", err)
+	}
+	ringNum, ok := planDiff.Attributes[ringNumKey]
+	if !ok || ringNum.Old != "1" || ringNum.New != "3" {
+		t.Fatalf("Expected ring_num to be updated in place from 1 to 3, got %+v", ringNum)
+	}
+	if userIDDiff, ok := planDiff.Attributes[userIDKey]; ok && userIDDiff.NewRemoved {
+		t.Fatalf("Expected the member to be kept, but the plan removes it")
+	}
+	if membersCount, ok := planDiff.Attributes["members.#"]; ok && membersCount.Old != membersCount.New {
+		t.Fatalf("Expected the member count to be unchanged, got %+v", membersCount)
+	}
+}
+
+func TestAccResourceRoutingQueueMemberRingNumUpdate(t *testing.T) {
+	var (
+		queueResource     = "test-queue-member-ring"
+		queueName         = "Terraform Test Queue-" + uuid.NewString()
+		fullQueueResource = "genesyscloud_routing_queue." + queueResource
+		userResource      = "test-queue-ring-user"
+		userEmail         = "terraform-" + uuid.NewString() + "@example.com"
+		userName          = "Henry Terraform"
+	)
+	generateConfig := func(ringNum string) string {
+		return generateRoutingQueueResourceBasic(
+			queueResource,
+			queueName,
+			generateMemberBlock("genesyscloud_user."+userResource+".id", ringNum),
+		) + generateBasicUserResource(
+			userResource,
+			userEmail,
+			userName,
+		)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: generateConfig("1"),
+				Check: resource.ComposeTestCheckFunc(
+					validateMember(fullQueueResource, "genesyscloud_user."+userResource, "1"),
+				),
+			},
+			{
+				// Changing only the ring number is a pending change
+				Config:             generateConfig("3"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				// The same member is kept with the new ring number
+				Config: generateConfig("3"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fullQueueResource, "members.#", "1"),
+					validateMember(fullQueueResource, "genesyscloud_user."+userResource, "3"),
+				),
+			},
+		},
+		CheckDestroy: testVerifyQueuesDestroyed,
+	})
+}
+
+func TestQueueMembersChangeSummaryInPlan(t *testing.T) {
+	var (
+		queueID       = uuid.NewString()
+		keptUserID    = uuid.NewString()
+		removedUserID = uuid.NewString()
+		addedUserID   = uuid.NewString()
+	)
+	memberKey := func(userID string, attr string) string {
+		return fmt.Sprintf("members.%d.%s", queueMemberHash(map[string]interface{}{"user_id": userID}), attr)
+	}
+
+	state := &terraform.InstanceState{
+		ID: queueID,
+		Attributes: map[string]string{
+			"id":                                 queueID,
+			"name":                               "Terraform Test Queue",
+			"members.#":                          "2",
+			memberKey(keptUserID, "user_id"):     keptUserID,
+			memberKey(keptUserID, "ring_num"):    "1",
+			memberKey(removedUserID, "user_id"):  removedUserID,
+			memberKey(removedUserID, "ring_num"): "1",
+		},
+	}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name": "Terraform Test Queue",
+		"members": []interface{}{
+			map[string]interface{}{"user_id": keptUserID, "ring_num": 1},
+			map[string]interface{}{"user_id": addedUserID, "ring_num": 1},
+		},
+	})
+
+	planDiff, err := resourceRoutingQueue().Diff(context.Background(), state, config, &providerMeta{})
+	if err != nil {
+		t.Fatalf("Failed to plan queue: %v", err)
+	}
+	summary, ok := planDiff.Attributes["members_change_summary"]
+	if !ok || summary.New != "1 to add, 1 to remove, 2 after apply" {
+		t.Fatalf("Expected the plan to include the membership change summary, got %+v", summary)
+	}
+}
+
 func TestAccResourceRoutingQueueMembers(t *testing.T) {
 	var (
 		queueResource        = "test-queue-members"
@@ -361,6 +573,8 @@ func TestAccResourceRoutingQueueMembers(t *testing.T) {
 				),
 				Check: resource.ComposeTestCheckFunc(
 					validateMember("genesyscloud_routing_queue."+queueResource, "genesyscloud_user."+queueMemberResource2, queueRingNum),
+					// The planned summary is kept in state after the apply
+					resource.TestCheckResourceAttr("genesyscloud_routing_queue."+queueResource, "members_change_summary", "0 to add, 1 to remove, 1 after apply"),
 				),
 			},
 			{
@@ -379,7 +593,50 @@ func TestAccResourceRoutingQueueMembers(t *testing.T) {
 			},
 			{
 				// Import/Read
-				ResourceName:      "genesyscloud_routing_queue." + queueResource,
+				ResourceName:            "genesyscloud_routing_queue." + queueResource,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"members_change_summary"},
+			},
+		},
+		CheckDestroy: testVerifyQueuesDestroyed,
+	})
+}
+
+func TestAccResourceRoutingQueueMembersImportAllPages(t *testing.T) {
+	var (
+		queueResource     = "test-queue-bulk-members"
+		queueName         = "Terraform Test Queue Bulk-" + uuid.NewString()
+		fullQueueResource = "genesyscloud_routing_queue." + queueResource
+		userEmailSuffix   = uuid.NewString() + "@example.com"
+		// More than two pages of members
+		memberCount = 251
+	)
+
+	config := fmt.Sprintf(`resource "genesyscloud_user" "test_bulk_members" {
+		count = %d
+		email = "terraform-bulk-${count.index}-%s"
+		name  = "Terraform Bulk ${count.index}"
+	}
+	`, memberCount, userEmailSuffix) + generateRoutingQueueResourceBasic(
+		queueResource,
+		queueName,
+		"members = [for user in genesyscloud_user.test_bulk_members : { user_id = user.id, ring_num = 1 }]",
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fullQueueResource, "members.#", strconv.Itoa(memberCount)),
+				),
+			},
+			{
+				// Import/Read must page through every member
+				ResourceName:      fullQueueResource,
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
@@ -482,6 +739,286 @@ func TestAccResourceRoutingQueueWrapupCodes(t *testing.T) {
 	})
 }
 
+func TestAccResourceRoutingQueueMemberGroups(t *testing.T) {
+	var (
+		queueResource      = "test-queue-member-groups"
+		queueName          = "Terraform Test Queue-" + uuid.NewString()
+		skillGroupResource = "test-skill-group"
+		skillGroupName     = "Terraform Test Skill Group-" + uuid.NewString()
+		groupResource      = "test-group"
+		groupName          = "Terraform Test Group-" + uuid.NewString()
+		fullQueueResource  = "genesyscloud_routing_queue." + queueResource
+	)
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				// Create with a skill group and a group
+				Config: generateRoutingQueueResourceBasic(
+					queueResource,
+					queueName,
+					"skill_groups = [genesyscloud_routing_skill_group."+skillGroupResource+".id]",
+					"groups = [genesyscloud_group."+groupResource+".id]",
+				) + generateRoutingSkillGroupResourceBasic(
+					skillGroupResource,
+					skillGroupName,
+					"Terraform queue member group test",
+				) + generateBasicGroupResource(
+					groupResource,
+					groupName,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fullQueueResource, "skill_groups.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(fullQueueResource, "skill_groups.*", "genesyscloud_routing_skill_group."+skillGroupResource, "id"),
+					resource.TestCheckResourceAttr(fullQueueResource, "groups.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(fullQueueResource, "groups.*", "genesyscloud_group."+groupResource, "id"),
+				),
+			},
+			{
+				// Remove both
+				Config: generateRoutingQueueResourceBasic(
+					queueResource,
+					queueName,
+					"skill_groups = []",
+					"groups = []",
+				) + generateRoutingSkillGroupResourceBasic(
+					skillGroupResource,
+					skillGroupName,
+					"Terraform queue member group test",
+				) + generateBasicGroupResource(
+					groupResource,
+					groupName,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fullQueueResource, "skill_groups.#", "0"),
+					resource.TestCheckResourceAttr(fullQueueResource, "groups.#", "0"),
+				),
+			},
+			{
+				// Import/Read
+				ResourceName:      fullQueueResource,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+		CheckDestroy: testVerifyQueuesDestroyed,
+	})
+}
+
+func TestAccResourceRoutingQueueDefaultScriptIdsKeyCase(t *testing.T) {
+	var (
+		queueResource     = "test-queue-scripts"
+		queueName         = "Terraform Test Queue-" + uuid.NewString()
+		fullQueueResource = "genesyscloud_routing_queue." + queueResource
+		chatScriptID      = "81ddba00-9fad-11e7-9a00-3137c42c4ae9"
+		emailScriptID     = "153fcff5-597e-4f17-94e5-17eac456a0b2"
+	)
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				// Mixed case keys are read back in uppercase without a diff
+				Config: generateRoutingQueueResourceBasic(
+					queueResource,
+					queueName,
+					fmt.Sprintf(`default_script_ids = {
+						chat  = "%s"
+						Email = "%s"
+					}`, chatScriptID, emailScriptID),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fullQueueResource, "default_script_ids.CHAT", chatScriptID),
+					resource.TestCheckResourceAttr(fullQueueResource, "default_script_ids.EMAIL", emailScriptID),
+				),
+			},
+			{
+				// Switching to uppercase keys is not a change
+				Config: generateRoutingQueueResourceBasic(
+					queueResource,
+					queueName,
+					generateDefaultScriptIDs(chatScriptID, emailScriptID),
+				),
+				PlanOnly: true,
+			},
+			{
+				// Import/Read
+				ResourceName:      fullQueueResource,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+		CheckDestroy: testVerifyQueuesDestroyed,
+	})
+}
+
+func TestAccResourceRoutingQueueRemoveDefaultScriptIds(t *testing.T) {
+	var (
+		queueResource     = "test-queue-remove-scripts"
+		queueName         = "Terraform Test Queue-" + uuid.NewString()
+		fullQueueResource = "genesyscloud_routing_queue." + queueResource
+		chatScriptID      = "81ddba00-9fad-11e7-9a00-3137c42c4ae9"
+		emailScriptID     = "153fcff5-597e-4f17-94e5-17eac456a0b2"
+	)
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				// Create with default scripts
+				Config: generateRoutingQueueResourceBasic(
+					queueResource,
+					queueName,
+					generateDefaultScriptIDs(chatScriptID, emailScriptID),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fullQueueResource, "default_script_ids.%", "2"),
+				),
+			},
+			{
+				// Remove all default scripts
+				Config: generateRoutingQueueResourceBasic(
+					queueResource,
+					queueName,
+					"default_script_ids = {}",
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr(fullQueueResource, "default_script_ids.%"),
+				),
+			},
+		},
+		CheckDestroy: testVerifyQueuesDestroyed,
+	})
+}
+
+func TestAccResourceRoutingQueueUnmanagedDefaultScriptIds(t *testing.T) {
+	var (
+		queueResource     = "test-queue-unmanaged-scripts"
+		queueName         = "Terraform Test Queue-" + uuid.NewString()
+		fullQueueResource = "genesyscloud_routing_queue." + queueResource
+		chatScriptID      = "81ddba00-9fad-11e7-9a00-3137c42c4ae9"
+	)
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				// Create without default scripts, then set a script outside of Terraform
+				Config: generateRoutingQueueResourceBasic(
+					queueResource,
+					queueName,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					setQueueDefaultScriptOutOfBand(fullQueueResource, "CHAT", chatScriptID),
+				),
+			},
+			{
+				// Update an unrelated attribute. The script is kept as default_script_ids is not set.
+				Config: generateRoutingQueueResourceBasic(
+					queueResource,
+					queueName,
+					`description = "Unmanaged default scripts"`,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fullQueueResource, "description", "Unmanaged default scripts"),
+					resource.TestCheckResourceAttr(fullQueueResource, "default_script_ids.CHAT", chatScriptID),
+				),
+			},
+		},
+		CheckDestroy: testVerifyQueuesDestroyed,
+	})
+}
+
+func TestAccResourceRoutingQueueRemoveDescription(t *testing.T) {
+	var (
+		queueResource = "test-queue-remove-description"
+		queueName     = "Terraform Test Queue-" + uuid.NewString()
+	)
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				// Create with a description
+				Config: generateRoutingQueueResourceBasic(
+					queueResource,
+					queueName,
+					"description = \"Terraform test description\"",
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("genesyscloud_routing_queue."+queueResource, "description", "Terraform test description"),
+				),
+			},
+			{
+				// Remove the description
+				Config: generateRoutingQueueResourceBasic(
+					queueResource,
+					queueName,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("genesyscloud_routing_queue."+queueResource, "description", ""),
+				),
+			},
+		},
+		CheckDestroy: testVerifyQueuesDestroyed,
+	})
+}
+
+func TestAccResourceRoutingQueueDescriptionTooLong(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: generateRoutingQueueResourceBasic(
+					"test-queue-long-description",
+					"Terraform Test Queue-"+uuid.NewString(),
+					"description = \""+strings.Repeat("a", 513)+"\"",
+				),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("expected length of description"),
+			},
+		},
+	})
+}
+
+func TestAccResourceRoutingQueueInvalidCallingPartyNumber(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: generateRoutingQueueResourceBasic(
+					"test-queue-calling-party-number",
+					"Terraform Test Queue-"+uuid.NewString(),
+					"calling_party_number = \"+1 317 555 1234\"",
+				),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("is not in E.164 format"),
+			},
+		},
+	})
+}
+
+func TestAccResourceRoutingQueueMediaSettingsValidation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: generateRoutingQueueResourceBasic(
+					"test-queue-media-validation",
+					"Terraform Test Queue-"+uuid.NewString(),
+					generateMediaSettings("media_settings_email", "300", "0.8", "999"),
+				),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`media_settings_email\.0\.service_level_duration_ms must be >= 1000`),
+			},
+		},
+	})
+}
+
 func testVerifyQueuesDestroyed(state *terraform.State) error {
 	routingAPI := platformclientv2.NewRoutingApi()
 	for _, rs := range state.RootModule().Resources {
@@ -608,6 +1145,13 @@ func generateQueueWrapupCodes(wrapupCodes ...string) string {
 
 func validateRoutingRules(resourceName string, ringNum int, operator string, threshold string, waitSec string) resource.TestCheckFunc {
 	ringNumStr := strconv.Itoa(ringNum)
+	if operator == "ANY" {
+		// Threshold is not sent or read for ANY rules
+		return resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr("genesyscloud_routing_queue."+resourceName, "routing_rules."+ringNumStr+".operator", operator),
+			resource.TestCheckResourceAttr("genesyscloud_routing_queue."+resourceName, "routing_rules."+ringNumStr+".wait_seconds", waitSec),
+		)
+	}
 	return resource.ComposeAggregateTestCheckFunc(
 		resource.TestCheckResourceAttr("genesyscloud_routing_queue."+resourceName, "routing_rules."+ringNumStr+".operator", operator),
 		resource.TestCheckResourceAttr("genesyscloud_routing_queue."+resourceName, "routing_rules."+ringNumStr+".threshold", threshold),
@@ -633,6 +1177,40 @@ func validateBullseyeSettings(resourceName string, numRings int, timeout string,
 	return resource.ComposeAggregateTestCheckFunc(checks...)
 }
 
+func setQueueDefaultScriptOutOfBand(queueResourceName string, commType string, scriptID string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		queueResource, ok := state.RootModule().Resources[queueResourceName]
+		if !ok {
+			return fmt.Errorf("Failed to find queue %s in state", queueResourceName)
+		}
+		queueID := queueResource.Primary.ID
+		routingAPI := platformclientv2.NewRoutingApi()
+
+		queue, _, err := routingAPI.GetRoutingQueue(queueID)
+		if err != nil {
+			return fmt.Errorf("Failed to read queue %s: %s", queueID, err)
+		}
+
+		// Copy the current settings so only the default scripts are changed
+		queueJson, err := json.Marshal(queue)
+		if err != nil {
+			return fmt.Errorf("Failed to marshal queue %s: %s", queueID, err)
+		}
+		var queueRequest platformclientv2.Queuerequest
+		if err := json.Unmarshal(queueJson, &queueRequest); err != nil {
+			return fmt.Errorf("Failed to unmarshal queue %s: %s", queueID, err)
+		}
+		queueRequest.DefaultScripts = &map[string]platformclientv2.Script{
+			commType: {Id: &scriptID},
+		}
+
+		if _, _, err := routingAPI.PutRoutingQueue(queueID, queueRequest); err != nil {
+			return fmt.Errorf("Failed to set default script on queue %s: %s", queueID, err)
+		}
+		return nil
+	}
+}
+
 func validateMember(queueResourceName string, userResourceName string, ringNum string) resource.TestCheckFunc {
 	return func(state *terraform.State) error {
 		queueResource, ok := state.RootModule().Resources[queueResourceName]
@@ -130,6 +130,20 @@ func resourceTfExport() *schema.Resource {
 				Default:     false,
 				ForceNew:    true,
 			},
+			"split_files_by_resource": {
+				Description: "Write each resource type to its own config file named after the type, e.g. 'genesyscloud_routing_queue.tf'. The main config file then only contains the terraform block and variables.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				ForceNew:    true,
+			},
+			"divisions": {
+				Description: "IDs of divisions to limit the export to. Only applies to resource types whose listings include a division, e.g. 'genesyscloud_routing_queue'. Other resource types are exported in full.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				ForceNew:    true,
+			},
 			"exclude_attributes": {
 				Description: "Attributes to exclude from the config when exporting resources. Each value should be of the form {resource_name}.{attribute}, e.g. 'genesyscloud_user.skills'. Excluded attributes must be optional.",
 				Type:        schema.TypeList,
@@ -152,6 +166,7 @@ func createTfExport(ctx context.Context, d *schema.ResourceData, meta interface{
 	var defaultFileName string
 	exportAsHCL := d.Get("export_as_hcl").(bool)
 	logPermissionErrors := d.Get("log_permission_errors").(bool)
+	splitFilesByResource := d.Get("split_files_by_resource").(bool)
 
 	if exportAsHCL {
 		defaultFileName = defaultTfHCLFile
@@ -194,11 +209,23 @@ func createTfExport(ctx context.Context, d *schema.ResourceData, meta interface{
 		}
 	}
 
+	if divisions, ok := d.GetOk("divisions"); ok {
+		divisionFilter := interfaceListToStrings(divisions.([]interface{}))
+		for _, exporter := range exporters {
+			exporter.DivisionFilter = divisionFilter
+		}
+	}
+
 	diagErr = buildSanitizedResourceMaps(exporters, newFilter, logPermissionErrors)
 	if diagErr != nil {
 		return diagErr
 	}
 
+	diagErr = loadDataSourceRefNames(ctx, exporters, logPermissionErrors)
+	if diagErr != nil {
+		return diagErr
+	}
+
 	includeStateFile := d.Get("include_state_file").(bool)
 	provider := New(version)()
 
@@ -244,6 +271,7 @@ func createTfExport(ctx context.Context, d *schema.ResourceData, meta interface{
 	// Generate the JSON config map
 	resourceTypeJSONMaps := make(map[string]map[string]jsonMap)
 	resourceTypeHCLBlocks := make([][]byte, 0)
+	resourceTypeHCLBlocksByType := make(map[string][][]byte)
 	unresolvedAttrs := make([]unresolvableAttributeInfo, 0)
 	for _, resource := range resources {
 		jsonResult, diagErr := instanceStateToJSONMap(resource.State, resource.CtyType)
@@ -267,10 +295,20 @@ func createTfExport(ctx context.Context, d *schema.ResourceData, meta interface{
 			unresolvedAttrs = append(unresolvedAttrs, unresolved...)
 		}
 
-		resourceTypeHCLBlocks = append(resourceTypeHCLBlocks, instanceStateToHCLBlock(resource.Type, resource.Name, jsonResult))
+		hclBlock := instanceStateToHCLBlock(resource.Type, resource.Name, jsonResult)
+		resourceTypeHCLBlocks = append(resourceTypeHCLBlocks, hclBlock)
+		resourceTypeHCLBlocksByType[resource.Type] = append(resourceTypeHCLBlocksByType[resource.Type], hclBlock)
 		resourceTypeJSONMaps[resource.Type][resource.Name] = jsonResult
 	}
 
+	dataSourceJSONMaps := getDataSourceRefs(exporters)
+	dataSourceHCLBlocks := make([][]byte, 0)
+	for dataSourceType, dataSources := range dataSourceJSONMaps {
+		for label, dataSource := range dataSources {
+			dataSourceHCLBlocks = append(dataSourceHCLBlocks, dataSourceToHCLBlock(dataSourceType, label, dataSource))
+		}
+	}
+
 	providerSource := sourceForVersion(version)
 	if includeStateFile {
 		if err := writeTfState(ctx, resources, d, providerSource); err != nil {
@@ -279,13 +317,26 @@ func createTfExport(ctx context.Context, d *schema.ResourceData, meta interface{
 	}
 
 	var err diag.Diagnostics
-	if exportAsHCL {
-		err = exportHCLConfig(resourceTypeHCLBlocks, unresolvedAttrs, providerSource, version, filePath, tfVarsFilePath)
+	if splitFilesByResource {
+		// Resources go in their own files, so the main config only holds the terraform block and variables
+		if exportAsHCL {
+			err = exportHCLConfig(dataSourceHCLBlocks, unresolvedAttrs, providerSource, version, filePath, tfVarsFilePath)
+			if err == nil {
+				err = exportHCLConfigByType(d, resourceTypeHCLBlocksByType)
+			}
+		} else {
+			err = exportJSONConfig(make(map[string]map[string]jsonMap), dataSourceJSONMaps, unresolvedAttrs, providerSource, version, filePath, tfVarsFilePath)
+			if err == nil {
+				err = exportJSONConfigByType(d, resourceTypeJSONMaps)
+			}
+		}
+	} else if exportAsHCL {
+		err = exportHCLConfig(append(dataSourceHCLBlocks, resourceTypeHCLBlocks...), unresolvedAttrs, providerSource, version, filePath, tfVarsFilePath)
 	} else {
-		err = exportJSONConfig(resourceTypeJSONMaps, unresolvedAttrs, providerSource, version, filePath, tfVarsFilePath)
+		err = exportJSONConfig(resourceTypeJSONMaps, dataSourceJSONMaps, unresolvedAttrs, providerSource, version, filePath, tfVarsFilePath)
 	}
 	if err != nil {
-		return diagErr
+		return err
 	}
 
 	d.SetId(filePath)
@@ -358,13 +409,13 @@ func exportHCLConfig(
 
 func exportJSONConfig(
 	resourceTypeJSONMaps map[string]map[string]jsonMap,
+	dataSourceJSONMaps map[string]map[string]jsonMap,
 	unresolvedAttrs []unresolvableAttributeInfo,
 	providerSource,
 	version,
 	filePath,
 	tfVarsFilePath string) diag.Diagnostics {
 	rootJSONObject := jsonMap{
-		"resource": resourceTypeJSONMaps,
 		"terraform": jsonMap{
 			"required_providers": jsonMap{
 				"genesyscloud": jsonMap{
@@ -375,6 +426,14 @@ func exportJSONConfig(
 		},
 	}
 
+	if len(resourceTypeJSONMaps) > 0 {
+		rootJSONObject["resource"] = resourceTypeJSONMaps
+	}
+
+	if len(dataSourceJSONMaps) > 0 {
+		rootJSONObject["data"] = dataSourceJSONMaps
+	}
+
 	if len(unresolvedAttrs) > 0 {
 		tfVars := make(map[string]interface{})
 		variable := make(map[string]jsonMap)
@@ -405,6 +464,37 @@ func exportJSONConfig(
 	return writeConfig(rootJSONObject, filePath)
 }
 
+func exportHCLConfigByType(d *schema.ResourceData, resourceTypeHCLBlocks map[string][][]byte) diag.Diagnostics {
+	for resType, hclBlocks := range resourceTypeHCLBlocks {
+		typeFilePath, diagErr := getFilePath(d, resType+".tf")
+		if diagErr != nil {
+			return diagErr
+		}
+		if err := writeHCLToFile(hclBlocks, typeFilePath); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func exportJSONConfigByType(d *schema.ResourceData, resourceTypeJSONMaps map[string]map[string]jsonMap) diag.Diagnostics {
+	for resType, jsonMaps := range resourceTypeJSONMaps {
+		typeFilePath, diagErr := getFilePath(d, resType+".tf.json")
+		if diagErr != nil {
+			return diagErr
+		}
+		typeJSONObject := jsonMap{
+			"resource": map[string]map[string]jsonMap{
+				resType: jsonMaps,
+			},
+		}
+		if err := writeConfig(typeJSONObject, typeFilePath); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func instanceStateToHCLBlock(resType, resName string, json jsonMap) []byte {
 	f := hclwrite.NewEmptyFile()
 	rootBody := f.Body()
@@ -418,6 +508,13 @@ func instanceStateToHCLBlock(resType, resName string, json jsonMap) []byte {
 	return []byte(newCopy)
 }
 
+func dataSourceToHCLBlock(dataSourceType, label string, json jsonMap) []byte {
+	f := hclwrite.NewEmptyFile()
+	block := f.Body().AppendNewBlock("data", []string{dataSourceType, label})
+	addBody(block.Body(), json)
+	return f.Bytes()
+}
+
 func addBody(body *hclwrite.Body, json jsonMap) {
 	for k, v := range json {
 		addValue(body, k, v)
@@ -631,6 +728,18 @@ func deleteTfExport(_ context.Context, d *schema.ResourceData, _ interface{}) di
 		os.Remove(tfVarsFile)
 	}
 
+	if d.Get("split_files_by_resource").(bool) {
+		for _, resType := range getAvailableExporterTypes() {
+			for _, typeFileName := range []string{resType + ".tf", resType + ".tf.json"} {
+				typeFile, _ := getFilePath(d, typeFileName)
+				if _, err := os.Stat(typeFile); err == nil {
+					log.Printf("Deleting export config %s", typeFile)
+					os.Remove(typeFile)
+				}
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -1269,6 +1378,9 @@ func resolveReference(refSettings *RefAttrSettings, refID string, exporters map[
 				return fmt.Sprintf("${%s.%s.id}", refSettings.RefType, meta.Name)
 			}
 		}
+	} else if meta, ok := refSettings.dataSourceMetas[refID]; ok && meta != nil {
+		// Referenced type is not being exported. Look it up by name instead
+		return fmt.Sprintf("${data.%s.%s.id}", refSettings.DataSourceType, addDataSourceRef(refSettings, meta))
 	}
 
 	if exportingState {
@@ -1279,6 +1391,70 @@ func resolveReference(refSettings *RefAttrSettings, refID string, exporters map[
 	return ""
 }
 
+// loadDataSourceRefNames loads the names and types of referenced resources that are not being exported but can be looked up with a data source
+func loadDataSourceRefNames(ctx context.Context, exporters map[string]*ResourceExporter, logErrors bool) diag.Diagnostics {
+	metasByType := make(map[string]ResourceIDMetaMap)
+	for _, exporter := range exporters {
+		for _, refSettings := range exporter.RefAttrs {
+			if refSettings.DataSourceType == "" || exporters[refSettings.RefType] != nil {
+				continue
+			}
+			if _, loaded := metasByType[refSettings.RefType]; !loaded {
+				refExporter := getResourceExporters(nil)[refSettings.RefType]
+				if refExporter == nil {
+					continue
+				}
+				log.Printf("Getting %s names and types for data source references", refSettings.RefType)
+				resources, err := refExporter.GetResourcesFunc(ctx)
+				if err != nil {
+					if containsPermissionsErrorOnly(err) && logErrors {
+						log.Printf("%v", err[0].Summary)
+						log.Printf("log_permission_errors = true. References to %s will be removed.", refSettings.RefType)
+						metasByType[refSettings.RefType] = nil
+						continue
+					}
+					return err
+				}
+				metasByType[refSettings.RefType] = resources
+			}
+			refSettings.dataSourceMetas = metasByType[refSettings.RefType]
+		}
+	}
+	return nil
+}
+
+// addDataSourceRef records a data source lookup of the given resource's name and type and returns its label
+func addDataSourceRef(refSettings *RefAttrSettings, meta *ResourceMeta) string {
+	label := sanitizeResourceName(meta.Name)
+	lookup := jsonMap{"name": meta.Name}
+	if meta.Type != "" {
+		// Resources of different types may share a name
+		label = sanitizeResourceName(meta.Name + "_" + meta.Type)
+		lookup["type"] = meta.Type
+	}
+	if refSettings.dataSourceRefs == nil {
+		refSettings.dataSourceRefs = make(map[string]jsonMap)
+	}
+	refSettings.dataSourceRefs[label] = lookup
+	return label
+}
+
+// getDataSourceRefs collects the data source lookups used by all exporters, keyed by data source type and label
+func getDataSourceRefs(exporters map[string]*ResourceExporter) map[string]map[string]jsonMap {
+	dataSources := make(map[string]map[string]jsonMap)
+	for _, exporter := range exporters {
+		for _, refSettings := range exporter.RefAttrs {
+			for label, lookup := range refSettings.dataSourceRefs {
+				if dataSources[refSettings.DataSourceType] == nil {
+					dataSources[refSettings.DataSourceType] = make(map[string]jsonMap)
+				}
+				dataSources[refSettings.DataSourceType][label] = lookup
+			}
+		}
+	}
+	return dataSources
+}
+
 func populateConfigExcluded(exporters map[string]*ResourceExporter, configExcluded []string) diag.Diagnostics {
 	for _, excluded := range configExcluded {
 		resourceIdx := strings.Index(excluded, ".")
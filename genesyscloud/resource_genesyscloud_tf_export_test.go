@@ -79,6 +79,148 @@ func TestAccResourceTfExport(t *testing.T) {
 	})
 }
 
+func TestAccResourceTfExportSplitFilesByResource(t *testing.T) {
+	var (
+		exportTestDir   = "../.terraform" + uuid.NewString()
+		exportResource1 = "test-export-split"
+		configPath      = filepath.Join(exportTestDir, defaultTfJSONFile)
+		skillConfigPath = filepath.Join(exportTestDir, "genesyscloud_routing_skill.tf.json")
+		skillResource   = "test-skill-split"
+		skillName       = "terraform-skill-split-" + uuid.NewString()
+	)
+
+	defer os.RemoveAll(exportTestDir)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: generateRoutingSkillResource(skillResource, skillName) + fmt.Sprintf(`resource "genesyscloud_tf_export" "%s" {
+					directory = "%s"
+					resource_types = ["genesyscloud_routing_skill"]
+					split_files_by_resource = true
+					depends_on = [genesyscloud_routing_skill.%s]
+				}
+				`, exportResource1, exportTestDir, skillResource),
+				Check: resource.ComposeTestCheckFunc(
+					validateFileCreated(configPath),
+					validateFileCreated(skillConfigPath),
+					func(state *terraform.State) error {
+						_, err := getResourceDefinition(skillConfigPath, "genesyscloud_routing_skill")
+						return err
+					},
+				),
+			},
+		},
+		CheckDestroy: func(state *terraform.State) error {
+			if _, err := os.Stat(skillConfigPath); !os.IsNotExist(err) {
+				return fmt.Errorf("Failed to delete resource config file %s", skillConfigPath)
+			}
+			return testVerifyExportsDestroyedFunc(exportTestDir)(state)
+		},
+	})
+}
+
+func TestAccResourceTfExportDivisionFilter(t *testing.T) {
+	var (
+		exportTestDir    = "../.terraform" + uuid.NewString()
+		exportResource1  = "test-export-division"
+		configPath       = filepath.Join(exportTestDir, defaultTfJSONFile)
+		divResource      = "test-export-division"
+		divName          = "terraform-export-division-" + uuid.NewString()
+		queueResource    = "test-export-division-queue"
+		queueName        = "Terraform Export Division Queue-" + uuid.NewString()
+		queueResourceDef = generateRoutingQueueResourceBasic(
+			queueResource,
+			queueName,
+			"division_id = genesyscloud_auth_division."+divResource+".id",
+		)
+	)
+
+	defer os.RemoveAll(exportTestDir)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: generateAuthDivisionBasic(divResource, divName) + queueResourceDef + fmt.Sprintf(`resource "genesyscloud_tf_export" "%s" {
+					directory = "%s"
+					resource_types = ["genesyscloud_routing_queue"]
+					divisions = [genesyscloud_auth_division.%s.id]
+					depends_on = [genesyscloud_routing_queue.%s]
+				}
+				`, exportResource1, exportTestDir, divResource, queueResource),
+				Check: resource.ComposeTestCheckFunc(
+					validateFileCreated(configPath),
+					func(state *terraform.State) error {
+						queues, err := getResourceDefinition(configPath, "genesyscloud_routing_queue")
+						if err != nil {
+							return err
+						}
+						if len(queues) != 1 {
+							return fmt.Errorf("expected only the queue in division %s to be exported, found %d queues", divName, len(queues))
+						}
+						return nil
+					},
+				),
+			},
+		},
+		CheckDestroy: testVerifyExportsDestroyedFunc(exportTestDir),
+	})
+}
+
+func TestAccResourceTfExportQueueFlowDataSourceRef(t *testing.T) {
+	var (
+		exportTestDir     = "../.terraform" + uuid.NewString()
+		exportResource1   = "test-export-flow-ref"
+		configPath        = filepath.Join(exportTestDir, defaultTfJSONFile)
+		queueResource     = "test-export-flow-ref-queue"
+		queueName         = "Terraform Export Flow Ref Queue-" + uuid.NewString()
+		flowResource      = "test_export_flow_ref"
+		flowName          = "Terraform Export Flow Ref-" + uuid.NewString()
+		flowFilePath      = "../examples/resources/genesyscloud_flow/inboundcall_flow_example.yaml"
+		flowConfig        = fmt.Sprintf("inboundCall:\n  name: %s\n  defaultLanguage: en-us\n  startUpRef: ./menus/menu[mainMenu]\n  initialGreeting:\n    tts: Archy says hi!!!\n  menus:\n    - menu:\n        name: Main Menu\n        audio:\n          tts: You are at the Main Menu, press 9 to disconnect.\n        refId: mainMenu\n        choices:\n          - menuDisconnect:\n              name: Disconnect\n              dtmf: digit_9", flowName)
+		flowDataSourceRef = fmt.Sprintf("${data.genesyscloud_flow.%s.id}", sanitizeResourceName(flowName+"_inboundcall"))
+	)
+
+	defer os.RemoveAll(exportTestDir)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				// Flows are not exported, so the queue flow reference should be a data source lookup by name and type
+				Config: generateFlowResource(
+					flowResource,
+					flowFilePath,
+					flowConfig,
+					false,
+				) + generateRoutingQueueResourceBasic(
+					queueResource,
+					queueName,
+					"queue_flow_id = genesyscloud_flow."+flowResource+".id",
+				) + generateTfExportByName(
+					exportResource1,
+					exportTestDir,
+					falseValue,
+					[]string{strconv.Quote("genesyscloud_routing_queue::" + queueName)},
+					"",
+					falseValue,
+					falseValue,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					validateFileCreated(configPath),
+					testQueueFlowDataSourceRef(configPath, sanitizeResourceName(queueName), flowName, "inboundcall", flowDataSourceRef),
+				),
+			},
+		},
+		CheckDestroy: testVerifyExportsDestroyedFunc(exportTestDir),
+	})
+}
+
 func TestAccResourceTfExportByName(t *testing.T) {
 	var (
 		exportTestDir   = "../.terraform" + uuid.NewString()
@@ -429,6 +571,92 @@ func TestAccResourceTfExportFormAsHCL(t *testing.T) {
 	})
 }
 
+func TestAccResourceTfExportSurveyFormAsHCL(t *testing.T) {
+	t.Parallel()
+	var (
+		exportTestDir    = "../.terraform" + uuid.NewString()
+		exportedContents string
+		pathToHclFile    = filepath.Join(exportTestDir, defaultTfHCLFile)
+		formName         = "terraform_form_surveys_" + uuid.NewString()
+		formResourceName = formName
+
+		surveyForm1 = surveyFormStruct{
+			name:     formName,
+			language: "en-US",
+			questionGroups: []surveyFormQuestionGroupStruct{
+				{
+					name: "Test Question Group 1",
+					questions: []surveyFormQuestionStruct{
+						{
+							text:    "Did the agent perform the opening spiel?",
+							varType: "multipleChoiceQuestion",
+							answerOptions: []answerOptionStruct{
+								{
+									text:  "Yes",
+									value: 1,
+								},
+								{
+									text:  "No",
+									value: 0,
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+	)
+
+	defer os.RemoveAll(exportTestDir)
+
+	surveyFormChecks := resource.ComposeTestCheckFunc(
+		resource.TestCheckResourceAttr("genesyscloud_quality_forms_survey."+formResourceName, "name", surveyForm1.name),
+		resource.TestCheckResourceAttr("genesyscloud_quality_forms_survey."+formResourceName, "question_groups.0.name", surveyForm1.questionGroups[0].name),
+		resource.TestCheckResourceAttr("genesyscloud_quality_forms_survey."+formResourceName, "question_groups.0.questions.0.text", surveyForm1.questionGroups[0].questions[0].text),
+		resource.TestCheckResourceAttr("genesyscloud_quality_forms_survey."+formResourceName, "question_groups.0.questions.0.answer_options.#", "2"),
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: generateSurveyFormResource(formResourceName, &surveyForm1),
+				Check:  surveyFormChecks,
+			},
+			{
+				Config: generateSurveyFormResource(formResourceName, &surveyForm1) + generateTfExportByName(
+					formResourceName,
+					exportTestDir,
+					trueValue,
+					[]string{strconv.Quote("genesyscloud_quality_forms_survey::" + formName)},
+					"",
+					trueValue,
+					falseValue,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					getExportedFileContents(pathToHclFile, &exportedContents),
+				),
+			},
+		},
+		CheckDestroy: testVerifyExportsDestroyedFunc(exportTestDir),
+	})
+
+	exportedContents = removeTfConfigBlock(exportedContents)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: exportedContents,
+				Check:  surveyFormChecks,
+			},
+		},
+		CheckDestroy: testVerifyExportsDestroyedFunc(exportTestDir),
+	})
+}
+
 func TestAccResourceTfExportQueueAsHCL(t *testing.T) {
 	t.Parallel()
 	var (
@@ -685,6 +913,38 @@ func testTrunkBaseSettingsExport(filePath, resourceType string) resource.TestChe
 	}
 }
 
+func testQueueFlowDataSourceRef(filePath, queueResourceName, flowName, flowType, expectedRef string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		tfExport, err := ioutil.ReadFile(filePath)
+		if err != nil {
+			return err
+		}
+
+		var config struct {
+			Resource map[string]map[string]map[string]interface{} `json:"resource"`
+			Data     map[string]map[string]map[string]interface{} `json:"data"`
+		}
+		if err := json.Unmarshal(tfExport, &config); err != nil {
+			return err
+		}
+
+		queue, ok := config.Resource["genesyscloud_routing_queue"][queueResourceName]
+		if !ok {
+			return fmt.Errorf("queue %s not found in the config file", queueResourceName)
+		}
+		if queue["queue_flow_id"] != expectedRef {
+			return fmt.Errorf("expected queue_flow_id to be %s, got %v", expectedRef, queue["queue_flow_id"])
+		}
+
+		for _, dataSource := range config.Data["genesyscloud_flow"] {
+			if dataSource["name"] == flowName && dataSource["type"] == flowType {
+				return nil
+			}
+		}
+		return fmt.Errorf("genesyscloud_flow data source with name %s and type %s not found in the config file", flowName, flowType)
+	}
+}
+
 func getResourceDefinition(filePath, resourceType string) (map[string]*json.RawMessage, error) {
 	tfExport, err := ioutil.ReadFile(filePath)
 	if err != nil {
@@ -767,6 +1027,32 @@ func TestForExportCycles(t *testing.T) {
 	}
 }
 
+func TestSanitizeResourceNameCollisions(t *testing.T) {
+	validLabel := regexp.MustCompile(`^[A-Za-z_][0-9A-Za-z_-]*$`)
+
+	// Each name sanitizes to the same base label "My_Segment__v2_"
+	collidingNames := []string{"My Segment (v2)", "My Segment [v2]", "My Segment {v2}"}
+	sanitizedNames := make(map[string]string)
+	for _, name := range collidingNames {
+		sanitized := sanitizeResourceName(name)
+		if !validLabel.MatchString(sanitized) {
+			t.Errorf("sanitized name %s for %s is not a valid resource label", sanitized, name)
+		}
+		if other, exists := sanitizedNames[sanitized]; exists {
+			t.Errorf("names %s and %s both sanitized to %s", other, name, sanitized)
+		}
+		sanitizedNames[sanitized] = name
+
+		if again := sanitizeResourceName(name); again != sanitized {
+			t.Errorf("sanitizing %s is not consistent: got %s and %s", name, sanitized, again)
+		}
+	}
+
+	if sanitized := sanitizeResourceName("2nd Segment"); !validLabel.MatchString(sanitized) {
+		t.Errorf("sanitized name %s does not start with a letter or underscore", sanitized)
+	}
+}
+
 func isIgnoredReferenceCycle(cycle []string) bool {
 	// Some cycles cannot be broken with a schema change and must be dealt with in the config
 	// These cycles can be ignored by this test
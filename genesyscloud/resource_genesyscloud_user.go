@@ -662,7 +662,7 @@ func updateUser(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 		return patchErr
 	}
 
-	diagErr := updateObjectDivision(d, "USER", sdkConfig)
+	diagErr := updateObjectDivision(d, "USER", meta)
 	if diagErr != nil {
 		return diagErr
 	}
@@ -129,7 +129,7 @@ func runWithPooledClient(method resContextFunc) resContextFunc {
 		// Copy to a new providerMeta object and set the sdk config
 		newMeta := *meta.(*providerMeta)
 		newMeta.ClientConfig = clientConfig
-		return method(ctx, r, &newMeta)
+		return method(withRetryTimeoutMultiplier(ctx, newMeta.RetryTimeoutMultiplier), r, &newMeta)
 	}
 }
 
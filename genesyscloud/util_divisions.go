@@ -3,6 +3,7 @@ package genesyscloud
 import (
 	"log"
 	"sync"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -16,6 +17,44 @@ var divOnce sync.Once
 var homeDivID string
 var homeDivErr diag.Diagnostics
 
+const (
+	// How long to collect division assignments before moving them with a single request
+	divisionBatchWindow = 2 * time.Second
+	// Maximum number of objects to move in a single request
+	divisionBatchMaxSize = 50
+)
+
+type divisionBatchKey struct {
+	divisionID string
+	objType    string
+}
+
+// Moves the objects to the division with a single request
+type divisionMoveFunc func(divisionID string, objType string, ids []string) error
+
+type divisionBatch struct {
+	ids []string
+	// Closed when the batch reaches divisionBatchMaxSize so it is moved without waiting for the window
+	full chan struct{}
+	done chan struct{}
+	err  error
+}
+
+// Groups division assignments made during the same apply by division and object type.
+// Set on providerMeta when the batch_division_assignments provider option is enabled.
+type divisionBatcher struct {
+	window  time.Duration
+	mutex   sync.Mutex
+	batches map[divisionBatchKey]*divisionBatch
+}
+
+func newDivisionBatcher(window time.Duration) *divisionBatcher {
+	return &divisionBatcher{
+		window:  window,
+		batches: make(map[divisionBatchKey]*divisionBatch),
+	}
+}
+
 func getHomeDivisionID() (string, diag.Diagnostics) {
 	divOnce.Do(func() {
 		authAPI := platformclientv2.NewAuthorizationApi()
@@ -33,9 +72,9 @@ func getHomeDivisionID() (string, diag.Diagnostics) {
 	return homeDivID, nil
 }
 
-func updateObjectDivision(d *schema.ResourceData, objType string, sdkConfig *platformclientv2.Configuration) diag.Diagnostics {
+func updateObjectDivision(d *schema.ResourceData, objType string, meta interface{}) diag.Diagnostics {
 	if d.HasChange("division_id") {
-		authAPI := platformclientv2.NewAuthorizationApiWithConfig(sdkConfig)
+		authAPI := platformclientv2.NewAuthorizationApiWithConfig(meta.(*providerMeta).ClientConfig)
 		divisionID := d.Get("division_id").(string)
 		if divisionID == "" {
 			// Default to home division
@@ -46,10 +85,80 @@ func updateObjectDivision(d *schema.ResourceData, objType string, sdkConfig *pla
 			divisionID = homeDivision
 		}
 		log.Printf("Updating division for %s %s to %s", objType, d.Id(), divisionID)
-		_, divErr := authAPI.PostAuthorizationDivisionObject(divisionID, objType, []string{d.Id()})
+		var divErr error
+		// Only queues are batched, as they are the objects created in bulk that move to the same division
+		if batcher := meta.(*providerMeta).DivisionBatcher; batcher != nil && objType == "QUEUE" {
+			divErr = batcher.add(func(divisionID string, objType string, ids []string) error {
+				_, err := authAPI.PostAuthorizationDivisionObject(divisionID, objType, ids)
+				return err
+			}, divisionID, objType, d.Id())
+		} else {
+			_, divErr = authAPI.PostAuthorizationDivisionObject(divisionID, objType, []string{d.Id()})
+		}
 		if divErr != nil {
 			return diag.Errorf("Failed to update division for %s %s: %s", objType, d.Id(), divErr)
 		}
 	}
 	return nil
 }
+
+// Adds the object to the pending batch for its division and object type, and waits until the batch has been moved.
+// The first object added starts the batch window, so concurrent updates within the window share a request.
+// A batch that reaches divisionBatchMaxSize objects is moved right away.
+func (b *divisionBatcher) add(move divisionMoveFunc, divisionID string, objType string, id string) error {
+	key := divisionBatchKey{divisionID: divisionID, objType: objType}
+
+	b.mutex.Lock()
+	batch, ok := b.batches[key]
+	if !ok {
+		batch = &divisionBatch{full: make(chan struct{}), done: make(chan struct{})}
+		b.batches[key] = batch
+		go b.flush(move, key, batch)
+	}
+	batch.ids = append(batch.ids, id)
+	if len(batch.ids) == divisionBatchMaxSize {
+		// Later assignments start a new batch
+		delete(b.batches, key)
+		close(batch.full)
+	}
+	b.mutex.Unlock()
+
+	<-batch.done
+	return batch.err
+}
+
+func (b *divisionBatcher) flush(move divisionMoveFunc, key divisionBatchKey, batch *divisionBatch) {
+	select {
+	case <-time.After(b.window):
+	case <-batch.full:
+	}
+
+	// Later assignments start a new batch
+	b.mutex.Lock()
+	if b.batches[key] == batch {
+		delete(b.batches, key)
+	}
+	ids := batch.ids
+	b.mutex.Unlock()
+
+	log.Printf("Moving %d %s object(s) to division %s", len(ids), key.objType, key.divisionID)
+	batch.err = move(key.divisionID, key.objType, ids)
+	close(batch.done)
+}
+
+// Returns an error if the home division fallback is disabled and division_id is configured as an empty string,
+// e.g. from an interpolated variable that resolved empty
+func checkHomeDivisionFallback(d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if !meta.(*providerMeta).DisableHomeDivisionFallback {
+		return nil
+	}
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		return nil
+	}
+	rawDivisionID := rawConfig.GetAttr("division_id")
+	if rawDivisionID.IsKnown() && !rawDivisionID.IsNull() && rawDivisionID.AsString() == "" {
+		return diag.Errorf("division_id for %s is empty and the home division fallback is disabled. Set division_id to a division ID or remove it to use the home division.", d.Get("name").(string))
+	}
+	return nil
+}
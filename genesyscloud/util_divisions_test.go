@@ -0,0 +1,151 @@
+package genesyscloud
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const testDivisionBatchWindow = 200 * time.Millisecond
+
+func TestDivisionBatchFullBatchesAndErrors(t *testing.T) {
+	const objCount = divisionBatchMaxSize + 20
+	var (
+		batcher    = newDivisionBatcher(testDivisionBatchWindow)
+		divisionID = uuid.NewString()
+		objType    = "QUEUE"
+		moveErr    = fmt.Errorf("move failed")
+
+		movesMutex sync.Mutex
+		moves      [][]string
+	)
+
+	// Fail the full batch, which is moved first without waiting for the window
+	move := func(moveDivisionID string, moveObjType string, ids []string) error {
+		if moveDivisionID != divisionID || moveObjType != objType {
+			t.Errorf("Unexpected move of %s objects to division %s", moveObjType, moveDivisionID)
+		}
+		movesMutex.Lock()
+		defer movesMutex.Unlock()
+		moves = append(moves, append([]string(nil), ids...))
+		if len(ids) == divisionBatchMaxSize {
+			return moveErr
+		}
+		return nil
+	}
+
+	var (
+		wg   sync.WaitGroup
+		ids  = make([]string, objCount)
+		errs = make([]error, objCount)
+	)
+	for i := range ids {
+		ids[i] = uuid.NewString()
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs[i] = batcher.add(move, divisionID, objType, ids[i])
+		}(i)
+	}
+	wg.Wait()
+
+	// Every object is moved once, in a full batch and a batch of the rest
+	if len(moves) != 2 {
+		t.Fatalf("Expected 2 move requests, got %d", len(moves))
+	}
+	movedIds := make(map[string]int)
+	for i, movedBatch := range moves {
+		for _, id := range movedBatch {
+			movedIds[id] = len(moves[i])
+		}
+	}
+	if len(movedIds) != objCount {
+		t.Fatalf("Expected %d objects to be moved, got %d", objCount, len(movedIds))
+	}
+
+	// Only the objects in the failed request get its error
+	for i, id := range ids {
+		batchSize, ok := movedIds[id]
+		if !ok {
+			t.Errorf("Object %s was not moved", id)
+			continue
+		}
+		if batchSize == divisionBatchMaxSize && errs[i] != moveErr {
+			t.Errorf("Expected object %s in the failed request to return its error, got %v", id, errs[i])
+		}
+		if batchSize != divisionBatchMaxSize && errs[i] != nil {
+			t.Errorf("Expected object %s in a successful request to succeed, got %v", id, errs[i])
+		}
+	}
+}
+
+func TestDivisionBatchFullBatchSkipsWindow(t *testing.T) {
+	// A window longer than the test timeout. Only a full batch can be moved.
+	batcher := newDivisionBatcher(time.Hour)
+	move := func(string, string, []string) error {
+		return nil
+	}
+
+	var wg sync.WaitGroup
+	divisionID := uuid.NewString()
+	for i := 0; i < divisionBatchMaxSize; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := batcher.add(move, divisionID, "QUEUE", uuid.NewString()); err != nil {
+				t.Errorf("Unexpected error moving object to division %s: %v", divisionID, err)
+			}
+		}()
+	}
+
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(10 * time.Second):
+		t.Fatal("Full batch was not moved before the batch window ended")
+	}
+}
+
+func TestDivisionBatchSeparatesDivisions(t *testing.T) {
+	var (
+		batcher     = newDivisionBatcher(testDivisionBatchWindow)
+		objType     = "QUEUE"
+		divisionIDs = []string{uuid.NewString(), uuid.NewString()}
+
+		movesMutex sync.Mutex
+		moved      = make(map[string][]string)
+	)
+	move := func(divisionID string, _ string, ids []string) error {
+		movesMutex.Lock()
+		defer movesMutex.Unlock()
+		moved[divisionID] = append(moved[divisionID], ids...)
+		return nil
+	}
+
+	var wg sync.WaitGroup
+	for _, divisionID := range divisionIDs {
+		for i := 0; i < 3; i++ {
+			wg.Add(1)
+			go func(divisionID string) {
+				defer wg.Done()
+				if err := batcher.add(move, divisionID, objType, uuid.NewString()); err != nil {
+					t.Errorf("Unexpected error moving object to division %s: %v", divisionID, err)
+				}
+			}(divisionID)
+		}
+	}
+	wg.Wait()
+
+	for _, divisionID := range divisionIDs {
+		if len(moved[divisionID]) != 3 {
+			t.Errorf("Expected 3 objects moved to division %s, got %d", divisionID, len(moved[divisionID]))
+		}
+	}
+}
@@ -4,7 +4,12 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
 	"reflect"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -45,6 +50,43 @@ func interfaceToString(val interface{}) string {
 	return fmt.Sprintf("%v", val)
 }
 
+// Request body that keeps a copy of the payload so it can be logged if the request fails
+type writePayloadBody struct {
+	io.Reader
+	payload []byte
+}
+
+func (b *writePayloadBody) Close() error {
+	return nil
+}
+
+// Keeps a copy of the body of create and update requests for logFailedWritePayload.
+// Called from the SDK request hook when the log_sdk_payloads provider option is enabled.
+func captureWritePayload(request *http.Request) {
+	if request == nil || request.Body == nil {
+		return
+	}
+	if request.Method != http.MethodPost && request.Method != http.MethodPut && request.Method != http.MethodPatch {
+		return
+	}
+	payload, _ := ioutil.ReadAll(request.Body)
+	request.Body.Close()
+	request.Body = &writePayloadBody{Reader: bytes.NewReader(payload), payload: payload}
+}
+
+// Logs the request body of a failed create or update captured by captureWritePayload.
+// Payloads are not logged by default as they may contain sensitive configuration.
+func logFailedWritePayload(response *http.Response) {
+	if response.Request == nil {
+		return
+	}
+	body, ok := response.Request.Body.(*writePayloadBody)
+	if !ok {
+		return
+	}
+	log.Printf("Failed %s %s. Request body: %s", response.Request.Method, response.Request.URL.Path, string(body.payload))
+}
+
 func jsonStringToInterface(jsonStr string) (interface{}, error) {
 	var obj interface{}
 	err := json.Unmarshal([]byte(jsonStr), &obj)
@@ -53,3 +95,35 @@ func jsonStringToInterface(jsonStr string) (interface{}, error) {
 	}
 	return obj, nil
 }
+
+// Returns true if each filter key matches the value of the same JSON property on the SDK entity.
+// Nested properties can be matched with dot separated keys, e.g. trunkMetabase.id
+func entityMatchesFilter(entity interface{}, filter map[string]interface{}) (bool, error) {
+	if len(filter) == 0 {
+		return true, nil
+	}
+
+	entityJson, err := json.Marshal(entity)
+	if err != nil {
+		return false, fmt.Errorf("Failed to marshal entity for filtering: %v", err)
+	}
+	var entityMap map[string]interface{}
+	if err := json.Unmarshal(entityJson, &entityMap); err != nil {
+		return false, fmt.Errorf("Failed to unmarshal entity for filtering: %v", err)
+	}
+
+	for key, expected := range filter {
+		var value interface{} = entityMap
+		for _, property := range strings.Split(key, ".") {
+			propertyMap, ok := value.(map[string]interface{})
+			if !ok {
+				return false, nil
+			}
+			value = propertyMap[property]
+		}
+		if value == nil || interfaceToString(value) != interfaceToString(expected) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
@@ -0,0 +1,67 @@
+package genesyscloud
+
+import (
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+// getPageFunc returns the resources on a single page along with the total number of pages
+type getPageFunc func(pageNum int) (ResourceIDMetaMap, int, diag.Diagnostics)
+
+// Maximum number of concurrent requests made by a single resource operation or exporter.
+// Kept small because resource operations and exporters already run concurrently, and the workers
+// share the one client the caller checked out of the SDK client pool.
+const maxConcurrentRequests = 4
+
+// getAllPagesParallel reads the first page to learn the page count, then fetches the remaining pages concurrently.
+// Concurrency is bounded by maxConcurrentRequests.
+func getAllPagesParallel(getPage getPageFunc) (ResourceIDMetaMap, diag.Diagnostics) {
+	resources, pageCount, err := getPage(1)
+	if err != nil {
+		return nil, err
+	}
+	if pageCount <= 1 {
+		return resources, nil
+	}
+
+	pageResults := make([]ResourceIDMetaMap, pageCount+1)
+	pageErrors := make([]diag.Diagnostics, pageCount+1)
+	pages := make(chan int)
+
+	var wg sync.WaitGroup
+	for i := 0; i < getWorkerCount(pageCount-1); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for pageNum := range pages {
+				pageResults[pageNum], _, pageErrors[pageNum] = getPage(pageNum)
+			}
+		}()
+	}
+	for pageNum := 2; pageNum <= pageCount; pageNum++ {
+		pages <- pageNum
+	}
+	close(pages)
+	wg.Wait()
+
+	// Merge in page order so the result doesn't depend on which page finished first
+	for pageNum := 2; pageNum <= pageCount; pageNum++ {
+		if pageErrors[pageNum] != nil {
+			return nil, pageErrors[pageNum]
+		}
+		for id, meta := range pageResults[pageNum] {
+			resources[id] = meta
+		}
+	}
+	return resources, nil
+}
+
+// getWorkerCount bounds concurrent API requests by maxConcurrentRequests and the number of tasks
+func getWorkerCount(tasks int) int {
+	workers := maxConcurrentRequests
+	if workers > tasks {
+		workers = tasks
+	}
+	return workers
+}
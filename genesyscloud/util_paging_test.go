@@ -0,0 +1,97 @@
+package genesyscloud
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+func TestGetAllPagesParallelMergesInPageOrder(t *testing.T) {
+	const pageCount = 10
+	var (
+		requestedMutex sync.Mutex
+		requested      = make(map[int]int)
+	)
+	getPage := func(pageNum int) (ResourceIDMetaMap, int, diag.Diagnostics) {
+		requestedMutex.Lock()
+		requested[pageNum]++
+		requestedMutex.Unlock()
+
+		// Every page has its own entity and the same shared entity
+		return ResourceIDMetaMap{
+			fmt.Sprintf("id-%d", pageNum): &ResourceMeta{Name: fmt.Sprintf("page %d", pageNum)},
+			"shared":                      &ResourceMeta{Name: fmt.Sprintf("page %d", pageNum)},
+		}, pageCount, nil
+	}
+
+	resources, err := getAllPagesParallel(getPage)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	for pageNum := 1; pageNum <= pageCount; pageNum++ {
+		if requested[pageNum] != 1 {
+			t.Errorf("Expected page %d to be requested once, got %d", pageNum, requested[pageNum])
+		}
+		if meta, ok := resources[fmt.Sprintf("id-%d", pageNum)]; !ok || meta.Name != fmt.Sprintf("page %d", pageNum) {
+			t.Errorf("Expected the entity from page %d in the results", pageNum)
+		}
+	}
+	if len(requested) != pageCount {
+		t.Errorf("Expected %d pages to be requested, got %d", pageCount, len(requested))
+	}
+	if len(resources) != pageCount+1 {
+		t.Errorf("Expected %d entities, got %d", pageCount+1, len(resources))
+	}
+	// Pages are merged in order, so the last page wins
+	if resources["shared"].Name != fmt.Sprintf("page %d", pageCount) {
+		t.Errorf("Expected the shared entity from the last page, got %s", resources["shared"].Name)
+	}
+}
+
+func TestGetAllPagesParallelLaterPageError(t *testing.T) {
+	const pageCount = 5
+	getPage := func(pageNum int) (ResourceIDMetaMap, int, diag.Diagnostics) {
+		if pageNum == 4 {
+			return nil, pageCount, diag.Errorf("failed to get page %d", pageNum)
+		}
+		return ResourceIDMetaMap{fmt.Sprintf("id-%d", pageNum): &ResourceMeta{Name: "entity"}}, pageCount, nil
+	}
+
+	resources, err := getAllPagesParallel(getPage)
+	if err == nil {
+		t.Fatal("Expected an error from page 4")
+	}
+	if err[0].Summary != "failed to get page 4" {
+		t.Errorf("Expected the page 4 error, got %s", err[0].Summary)
+	}
+	if resources != nil {
+		t.Errorf("Expected no results when a page fails, got %d", len(resources))
+	}
+}
+
+func TestGetAllPagesParallelSinglePage(t *testing.T) {
+	for _, pageCount := range []int{0, 1} {
+		requested := 0
+		getPage := func(pageNum int) (ResourceIDMetaMap, int, diag.Diagnostics) {
+			requested++
+			if pageNum != 1 {
+				t.Errorf("Expected only page 1 to be requested, got page %d", pageNum)
+			}
+			return ResourceIDMetaMap{"id-1": &ResourceMeta{Name: "entity"}}, pageCount, nil
+		}
+
+		resources, err := getAllPagesParallel(getPage)
+		if err != nil {
+			t.Fatalf("Unexpected error with page count %d: %v", pageCount, err)
+		}
+		if requested != 1 {
+			t.Errorf("Expected 1 request with page count %d, got %d", pageCount, requested)
+		}
+		if len(resources) != 1 {
+			t.Errorf("Expected 1 entity with page count %d, got %d", pageCount, len(resources))
+		}
+	}
+}
@@ -15,11 +15,34 @@ import (
 	"github.com/mypurecloud/platform-client-sdk-go/v80/platformclientv2"
 )
 
+type retryTimeoutMultiplierKey struct{}
+
+// Carries the provider retry_timeout_multiplier to the retry helpers, which scale retry deadlines for slow or throttled orgs
+func withRetryTimeoutMultiplier(ctx context.Context, multiplier float64) context.Context {
+	return context.WithValue(ctx, retryTimeoutMultiplierKey{}, multiplier)
+}
+
+func scaleRetryTimeout(ctx context.Context, timeout time.Duration) time.Duration {
+	if multiplier, ok := ctx.Value(retryTimeoutMultiplierKey{}).(float64); ok && multiplier > 0 {
+		return time.Duration(float64(timeout) * multiplier)
+	}
+	return timeout
+}
+
+// Returns a new context for retrying after a timeout that keeps the retry_timeout_multiplier of the original context
+func retryTimeoutContext(ctx context.Context, timeout time.Duration) context.Context {
+	retryCtx := context.Background()
+	if multiplier, ok := ctx.Value(retryTimeoutMultiplierKey{}).(float64); ok {
+		retryCtx = withRetryTimeoutMultiplier(retryCtx, multiplier)
+	}
+	retryCtx, _ = context.WithTimeout(retryCtx, scaleRetryTimeout(ctx, timeout))
+	return retryCtx
+}
+
 func withRetries(ctx context.Context, timeout time.Duration, method func() *resource.RetryError) diag.Diagnostics {
-	err := diag.FromErr(resource.RetryContext(ctx, timeout, method))
+	err := diag.FromErr(resource.RetryContext(ctx, scaleRetryTimeout(ctx, timeout), method))
 	if err != nil && strings.Contains(fmt.Sprintf("%v", err), "timeout while waiting for state to become") {
-		ctx, _ := context.WithTimeout(context.Background(), timeout)
-		return withRetries(ctx, timeout, method)
+		return withRetries(retryTimeoutContext(ctx, timeout), timeout, method)
 	}
 	return err
 }
@@ -29,7 +52,7 @@ func withRetriesForRead(ctx context.Context, d *schema.ResourceData, method func
 }
 
 func withRetriesForReadCustomTimeout(ctx context.Context, timeout time.Duration, d *schema.ResourceData, method func() *resource.RetryError) diag.Diagnostics {
-	err := diag.FromErr(resource.RetryContext(ctx, timeout, method))
+	err := diag.FromErr(resource.RetryContext(ctx, scaleRetryTimeout(ctx, timeout), method))
 	if err != nil {
 		if strings.Contains(fmt.Sprintf("%v", err), "API Error: 404") {
 			// Set ID empty if the object isn't found after the specified timeout
@@ -38,8 +61,7 @@ func withRetriesForReadCustomTimeout(ctx context.Context, timeout time.Duration,
 		errStringLower := strings.ToLower(fmt.Sprintf("%v", err))
 		if strings.Contains(errStringLower, "timeout while waiting for state to become") ||
 			strings.Contains(errStringLower, "context deadline exceeded") {
-			ctx, _ := context.WithTimeout(context.Background(), timeout)
-			return withRetriesForRead(ctx, d, method)
+			return withRetriesForRead(retryTimeoutContext(ctx, timeout), d, method)
 		}
 		if d.Id() != "" {
 			consistency_checker.DeleteConsistencyCheck(d.Id())
@@ -96,6 +118,14 @@ func isVersionMismatch(resp *platformclientv2.APIResponse, additionalCodes ...in
 	return false
 }
 
+// Returns the raw response body for error messages. The response is nil when the request never reached the API.
+func getBody(resp *platformclientv2.APIResponse) string {
+	if resp == nil {
+		return ""
+	}
+	return string(resp.RawBody)
+}
+
 func isStatus404(resp *platformclientv2.APIResponse, additionalCodes ...int) bool {
 	if resp != nil {
 		if resp.StatusCode == http.StatusNotFound ||
@@ -21,6 +21,19 @@ func validatePhoneNumber(number interface{}, _ cty.Path) diag.Diagnostics {
 	return diag.Errorf("Phone number %v is not a string", number)
 }
 
+var e164NumberRegex = regexp.MustCompile(`^\+?[1-9]\d{1,14}$`)
+
+// Validates a phone number is in E.164 format or is a short code. The leading + is optional so digit-only short codes are accepted.
+func validateE164PhoneNumber(number interface{}, _ cty.Path) diag.Diagnostics {
+	if numberStr, ok := number.(string); ok {
+		if !e164NumberRegex.MatchString(numberStr) {
+			return diag.Errorf("Phone number %q is not in E.164 format, e.g. +13175551234, or a short code of digits only. Remove any spaces or punctuation", numberStr)
+		}
+		return nil
+	}
+	return diag.Errorf("Phone number %v is not a string", number)
+}
+
 // Validates a date string is in the format yyyy-MM-dd
 func validateDate(date interface{}, _ cty.Path) diag.Diagnostics {
 	if dateStr, ok := date.(string); ok {
@@ -0,0 +1,17 @@
+resource "genesyscloud_journey_segment" "terraform_test_-TEST-CASE-" {
+  display_name            = "terraform_test_-TEST-CASE-_to_find"
+  color                   = "#008000"
+  scope                   = "Customer"
+  should_display_to_agent = false
+  is_active               = false
+  external_segment {
+    id     = "4654654654"
+    name   = "external segment name"
+    source = "AdobeExperiencePlatform"
+  }
+}
+
+data "genesyscloud_journey_segment" "terraform_test_-TEST-CASE-" {
+  name       = "terraform_test_-TEST-CASE-_to_find"
+  depends_on = [genesyscloud_journey_segment.terraform_test_-TEST-CASE-]
+}